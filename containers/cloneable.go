@@ -0,0 +1,23 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containers
+
+// Cloneable provides copying and structural comparison of containers.
+//
+// C is the concrete container type (usually a pointer to it) and T is the type of the values held by the container.
+type Cloneable[C any, T any] interface {
+	// Clone returns a copy of the container holding the same elements in the same order.
+	// The structure of the container is copied directly, i.e. trees are not rebalanced.
+	// Values themselves are copied by assignment (shallow copy).
+	Clone() C
+
+	// DeepClone returns a copy of the container where every value is passed through the given copy function.
+	// Keys (of maps and trees) are copied by assignment.
+	DeepClone(copyValue func(value T) T) C
+
+	// Equal returns true if the other container holds equal elements in the same container order.
+	// Unordered containers are equal if they hold the same elements.
+	Equal(other C) bool
+}
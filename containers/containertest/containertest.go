@@ -0,0 +1,105 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package containertest implements conformance tests for implementations of the container interfaces.
+//
+// Every test function checks the documented semantics of an interface, including out-of-range arguments
+// and, for containers implementing both containers.JSONSerializer and containers.JSONDeserializer, a JSON round-trip.
+// Containers are created empty by the given factory and hold at most 10 elements at a time.
+//
+// Usage in the tests of a custom list:
+//
+//	func TestListConformance(t *testing.T) {
+//		containertest.TestList(t, func() lists.List[int] { return mylist.New[int]() })
+//	}
+package containertest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"slices"
+	"testing"
+)
+
+// assertValues checks that the container holds exactly the expected values in the given order.
+func assertValues[T any](t *testing.T, container containers.Container[T], expected ...T) {
+	t.Helper()
+	assertSize(t, container, len(expected))
+	if actualValue, expectedValue := fmt.Sprint(container.Values()), fmt.Sprint(expected); actualValue != expectedValue {
+		t.Errorf("Got values %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertSortedValues checks that the container holds exactly the expected values in any order.
+func assertSortedValues[T cmp.Ordered](t *testing.T, container containers.Container[T], expected ...T) {
+	t.Helper()
+	assertSize(t, container, len(expected))
+	values := container.Values()
+	slices.Sort(values)
+	if actualValue, expectedValue := fmt.Sprint(values), fmt.Sprint(expected); actualValue != expectedValue {
+		t.Errorf("Got values %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertSize checks Size and Empty of the container.
+func assertSize[T any](t *testing.T, container containers.Container[T], expected int) {
+	t.Helper()
+	if actualValue, expectedValue := container.Size(), expected; actualValue != expectedValue {
+		t.Errorf("Got size %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := container.Empty(), expected == 0; actualValue != expectedValue {
+		t.Errorf("Got empty %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertString checks that the string representation of the container is not empty.
+func assertString[T any](t *testing.T, container containers.Container[T]) {
+	t.Helper()
+	if container.String() == "" {
+		t.Errorf("Got empty string representation")
+	}
+}
+
+// testJSON serializes the container and restores the output into new containers, both with the container's own
+// methods and with the encoding/json package, and passes every restored container to the given assertion.
+// Skips the test if the container does not implement JSON serialization.
+func testJSON[C any](t *testing.T, container C, newContainer func() C, assert func(restored C)) {
+	t.Helper()
+	serializer, ok := any(container).(containers.JSONSerializer)
+	if !ok {
+		t.Skip("container does not implement containers.JSONSerializer")
+	}
+	if _, ok := any(container).(containers.JSONDeserializer); !ok {
+		t.Skip("container does not implement containers.JSONDeserializer")
+	}
+	data, err := serializer.ToJSON()
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	marshaled, err := json.Marshal(serializer)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(marshaled), string(data); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	restored := newContainer()
+	if err := any(restored).(containers.JSONDeserializer).FromJSON(data); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	assert(restored)
+
+	unmarshaled := newContainer()
+	if err := json.Unmarshal(data, unmarshaled); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	assert(unmarshaled)
+
+	if err := any(newContainer()).(containers.JSONDeserializer).FromJSON([]byte("{invalid")); err == nil {
+		t.Errorf("Got no error for invalid JSON")
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"testing"
+)
+
+// TestIteratorWithKey checks that iterators created by the given factory implement the documented semantics of
+// containers.IteratorWithKey, and of containers.ReverseIteratorWithKey if they implement it.
+// The factory has to return a new iterator over a new container holding the given entries, which are passed
+// in the order the iterator is expected to visit them.
+func TestIteratorWithKey(t *testing.T, newIterator func(keys []int, values []string) containers.IteratorWithKey[int, string]) {
+	keys, values := []int{1, 2, 3}, []string{"a", "b", "c"}
+
+	t.Run("Empty", func(t *testing.T) {
+		it := newIterator(nil, nil)
+		if actualValue := it.Next(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if actualValue := it.First(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		it.Begin()
+		if actualValue := it.NextTo(func(key int, value string) bool { return true }); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if reverse, ok := it.(containers.ReverseIteratorWithKey[int, string]); ok {
+			if actualValue := reverse.Prev(); actualValue != false {
+				t.Errorf("Got %v expected %v", actualValue, false)
+			}
+			if actualValue := reverse.Last(); actualValue != false {
+				t.Errorf("Got %v expected %v", actualValue, false)
+			}
+			reverse.End()
+			if actualValue := reverse.PrevTo(func(key int, value string) bool { return true }); actualValue != false {
+				t.Errorf("Got %v expected %v", actualValue, false)
+			}
+		}
+	})
+
+	t.Run("Next", func(t *testing.T) {
+		it := newIterator(keys, values)
+		assertEntries(t, collect(it.Next, it), keys, values)
+		if actualValue := it.Next(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	})
+
+	t.Run("Begin", func(t *testing.T) {
+		it := newIterator(keys, values)
+		for it.Next() {
+		}
+		it.Begin()
+		assertEntries(t, collect(it.Next, it), keys, values)
+	})
+
+	t.Run("First", func(t *testing.T) {
+		it := newIterator(keys, values)
+		it.Next()
+		it.Next()
+		if actualValue := it.First(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		assertEntry(t, it, keys[0], values[0])
+		assertEntries(t, collect(it.Next, it), keys[1:], values[1:])
+	})
+
+	t.Run("NextTo", func(t *testing.T) {
+		it := newIterator(keys, values)
+		seek := func(key int, value string) bool { return value != values[0] }
+		for index := 1; index < len(keys); index++ {
+			if actualValue := it.NextTo(seek); actualValue != true {
+				t.Errorf("Got %v expected %v", actualValue, true)
+			}
+			assertEntry(t, it, keys[index], values[index])
+		}
+		if actualValue := it.NextTo(seek); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	})
+
+	if _, ok := newIterator(keys, values).(containers.ReverseIteratorWithKey[int, string]); !ok {
+		return
+	}
+	reversedKeys, reversedValues := []int{3, 2, 1}, []string{"c", "b", "a"}
+
+	t.Run("Prev", func(t *testing.T) {
+		it := newIterator(keys, values).(containers.ReverseIteratorWithKey[int, string])
+		for it.Next() {
+		}
+		assertEntries(t, collect(it.Prev, it), reversedKeys, reversedValues)
+		if actualValue := it.Prev(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		assertEntries(t, collect(it.Next, it), keys, values)
+	})
+
+	t.Run("End", func(t *testing.T) {
+		it := newIterator(keys, values).(containers.ReverseIteratorWithKey[int, string])
+		it.End()
+		assertEntries(t, collect(it.Prev, it), reversedKeys, reversedValues)
+		it.End()
+		if actualValue := it.Next(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	})
+
+	t.Run("Last", func(t *testing.T) {
+		it := newIterator(keys, values).(containers.ReverseIteratorWithKey[int, string])
+		if actualValue := it.Last(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		assertEntry(t, it, keys[2], values[2])
+		assertEntries(t, collect(it.Prev, it), reversedKeys[1:], reversedValues[1:])
+	})
+
+	t.Run("PrevTo", func(t *testing.T) {
+		it := newIterator(keys, values).(containers.ReverseIteratorWithKey[int, string])
+		it.End()
+		seek := func(key int, value string) bool { return value != values[2] }
+		for index := len(keys) - 2; index >= 0; index-- {
+			if actualValue := it.PrevTo(seek); actualValue != true {
+				t.Errorf("Got %v expected %v", actualValue, true)
+			}
+			assertEntry(t, it, keys[index], values[index])
+		}
+		if actualValue := it.PrevTo(seek); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	})
+}
+
+type entry struct {
+	key   int
+	value string
+}
+
+// collect moves the iterator with the given move function until it returns false and returns the visited entries.
+func collect(move func() bool, it containers.IteratorWithKey[int, string]) []entry {
+	var entries []entry
+	for move() {
+		entries = append(entries, entry{it.Key(), it.Value()})
+	}
+	return entries
+}
+
+// assertEntries checks that the entries hold the expected keys and values in the given order.
+func assertEntries(t *testing.T, entries []entry, expectedKeys []int, expectedValues []string) {
+	t.Helper()
+	expected := make([]entry, len(expectedKeys))
+	for index := range expectedKeys {
+		expected[index] = entry{expectedKeys[index], expectedValues[index]}
+	}
+	if actualValue, expectedValue := fmt.Sprint(entries), fmt.Sprint(expected); actualValue != expectedValue {
+		t.Errorf("Got entries %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// assertEntry checks the key and value of the current element of the iterator.
+func assertEntry(t *testing.T, it containers.IteratorWithKey[int, string], expectedKey int, expectedValue string) {
+	t.Helper()
+	if actualKey, actualValue := it.Key(), it.Value(); actualKey != expectedKey || actualValue != expectedValue {
+		t.Errorf("Got %v,%v expected %v,%v", actualKey, actualValue, expectedKey, expectedValue)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"github.com/ugurcsen/gods-generic/lists"
+	"github.com/ugurcsen/gods-generic/utils"
+	"testing"
+)
+
+// TestList checks that lists created by the given factory implement the documented semantics of lists.List.
+// The factory has to return a new empty list on every call.
+func TestList(t *testing.T, newList func() lists.List[int]) {
+	t.Run("Add", func(t *testing.T) {
+		list := newList()
+		assertValues(t, list)
+		list.Add()
+		list.Add(1)
+		list.Add(2, 3)
+		assertValues(t, list, 1, 2, 3)
+		assertString(t, list)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		list := newList()
+		if _, found := list.Get(0); found {
+			t.Errorf("Got %v expected %v", found, false)
+		}
+		list.Add(1, 2, 3)
+		for index, expectedValue := range []int{1, 2, 3} {
+			if actualValue, found := list.Get(index); actualValue != expectedValue || !found {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, found, expectedValue, true)
+			}
+		}
+		for _, index := range []int{-1, 3} {
+			if actualValue, found := list.Get(index); actualValue != 0 || found {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, found, 0, false)
+			}
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		list := newList()
+		list.Remove(0)
+		list.Add(1, 2, 3, 4, 5)
+		list.Remove(-1)
+		list.Remove(5)
+		assertValues(t, list, 1, 2, 3, 4, 5)
+		list.Remove(0)
+		assertValues(t, list, 2, 3, 4, 5)
+		list.Remove(3)
+		assertValues(t, list, 2, 3, 4)
+		list.Remove(1)
+		assertValues(t, list, 2, 4)
+		list.Remove(1)
+		list.Remove(0)
+		assertValues(t, list)
+		list.Add(6)
+		assertValues(t, list, 6)
+	})
+
+	t.Run("RemoveRange", func(t *testing.T) {
+		list := newList()
+		list.Add(1, 2, 3, 4, 5)
+		list.RemoveRange(-1, 2)
+		list.RemoveRange(2, 6)
+		list.RemoveRange(3, 3)
+		list.RemoveRange(3, 2)
+		assertValues(t, list, 1, 2, 3, 4, 5)
+		list.RemoveRange(1, 3)
+		assertValues(t, list, 1, 4, 5)
+		list.RemoveRange(0, 3)
+		assertValues(t, list)
+	})
+
+	t.Run("RemoveIf", func(t *testing.T) {
+		list := newList()
+		list.Add(1, 2, 3, 4, 5, 6)
+		if actualValue, expectedValue := list.RemoveIf(func(value int) bool { return value%2 == 0 }), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		assertValues(t, list, 1, 3, 5)
+		if actualValue, expectedValue := list.RetainIf(func(value int) bool { return value > 1 }), 1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		assertValues(t, list, 3, 5)
+		if actualValue, expectedValue := list.RemoveIf(func(value int) bool { return true }), 2; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		assertValues(t, list)
+	})
+
+	t.Run("Insert", func(t *testing.T) {
+		list := newList()
+		list.Insert(0, 1)
+		list.Insert(1, 4)
+		list.Insert(1, 2, 3)
+		list.Insert(0)
+		assertValues(t, list, 1, 2, 3, 4)
+		list.Insert(-1, 9)
+		list.Insert(5, 9)
+		assertValues(t, list, 1, 2, 3, 4)
+		list.Insert(0, -1, 0)
+		assertValues(t, list, -1, 0, 1, 2, 3, 4)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		list := newList()
+		list.Set(0, 1)
+		list.Add(2, 3)
+		list.Set(0, 10)
+		list.Set(3, 4)
+		list.Set(-1, 9)
+		list.Set(5, 9)
+		assertValues(t, list, 10, 2, 3, 4)
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		list := newList()
+		if actualValue := list.Contains(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := list.Contains(1); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		list.Add(1, 2, 3)
+		if actualValue := list.Contains(3, 1); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := list.Contains(1, 4); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		if actualValue := list.ContainsFunc(func(value int) bool { return value > 2 }); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := list.ContainsFunc(func(value int) bool { return value > 3 }); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	})
+
+	t.Run("IndexOf", func(t *testing.T) {
+		list := newList()
+		if actualValue, expectedValue := list.IndexOf(1), -1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Add(1, 2, 1)
+		// function, value, expectedIndex
+		tests := []struct {
+			f             func(value int) int
+			value         int
+			expectedIndex int
+		}{
+			{list.IndexOf, 1, 0},
+			{list.IndexOf, 2, 1},
+			{list.IndexOf, 3, -1},
+			{list.LastIndexOf, 1, 2},
+			{list.LastIndexOf, 3, -1},
+			{func(value int) int { return list.IndexOfFunc(func(v int) bool { return v > value }) }, 1, 1},
+			{func(value int) int { return list.IndexOfFunc(func(v int) bool { return v > value }) }, 2, -1},
+		}
+		for _, test := range tests {
+			if actualValue, expectedValue := test.f(test.value), test.expectedIndex; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		list := newList()
+		list.Sort(utils.NumberComparator[int])
+		assertValues(t, list)
+		list.Add(3, 1, 2, 1)
+		list.Sort(utils.NumberComparator[int])
+		assertValues(t, list, 1, 1, 2, 3)
+
+		list.Clear()
+		list.Add(21, 10, 20, 11)
+		byTens := func(a, b int) int { return utils.NumberComparator(a/10, b/10) }
+		list.SortStable(byTens)
+		assertValues(t, list, 10, 11, 21, 20)
+	})
+
+	t.Run("BinarySearch", func(t *testing.T) {
+		list := newList()
+		if actualIndex, found := list.BinarySearch(1, utils.NumberComparator[int]); actualIndex != 0 || found {
+			t.Errorf("Got %v,%v expected %v,%v", actualIndex, found, 0, false)
+		}
+		list.Add(1, 1, 3, 5)
+		// value, expectedIndex, expectedFound
+		tests := [][]interface{}{
+			{0, 0, false},
+			{1, 0, true},
+			{2, 2, false},
+			{3, 2, true},
+			{5, 3, true},
+			{6, 4, false},
+		}
+		for _, test := range tests {
+			if actualIndex, found := list.BinarySearch(test[0].(int), utils.NumberComparator[int]); actualIndex != test[1] || found != test[2] {
+				t.Errorf("Got %v,%v expected %v,%v", actualIndex, found, test[1], test[2])
+			}
+		}
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		list := newList()
+		list.Swap(0, 1)
+		list.Add(1, 2, 3)
+		list.Swap(0, 2)
+		assertValues(t, list, 3, 2, 1)
+		list.Swap(1, 1)
+		list.Swap(-1, 0)
+		list.Swap(0, 3)
+		assertValues(t, list, 3, 2, 1)
+	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		list := newList()
+		list.Reverse()
+		assertValues(t, list)
+		list.Add(1)
+		list.Reverse()
+		assertValues(t, list, 1)
+		list.Add(2, 3)
+		list.Reverse()
+		assertValues(t, list, 3, 2, 1)
+		list.Add(0)
+		assertValues(t, list, 3, 2, 1, 0)
+	})
+
+	t.Run("Rotate", func(t *testing.T) {
+		list := newList()
+		list.Rotate(1)
+		assertValues(t, list)
+		list.Add(1, 2, 3, 4, 5)
+		list.Rotate(2)
+		assertValues(t, list, 4, 5, 1, 2, 3)
+		list.Rotate(-2)
+		assertValues(t, list, 1, 2, 3, 4, 5)
+		list.Rotate(5)
+		assertValues(t, list, 1, 2, 3, 4, 5)
+		list.Rotate(-6)
+		assertValues(t, list, 2, 3, 4, 5, 1)
+		list.Add(6)
+		assertValues(t, list, 2, 3, 4, 5, 1, 6)
+	})
+
+	t.Run("AddAll", func(t *testing.T) {
+		list, other := newList(), newList()
+		list.AddAll(other)
+		assertValues(t, list)
+		list.Add(1, 2)
+		other.Add(3, 4)
+		list.AddAll(other)
+		assertValues(t, list, 1, 2, 3, 4)
+		list.InsertAll(1, other)
+		assertValues(t, list, 1, 3, 4, 2, 3, 4)
+		list.InsertAll(-1, other)
+		list.InsertAll(7, other)
+		assertValues(t, list, 1, 3, 4, 2, 3, 4)
+		list.InsertAll(6, other)
+		assertValues(t, list, 1, 3, 4, 2, 3, 4, 3, 4)
+		assertValues(t, other, 3, 4)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		list := newList()
+		list.Clear()
+		assertValues(t, list)
+		list.Add(1, 2)
+		list.Clear()
+		assertValues(t, list)
+		list.Add(3)
+		assertValues(t, list, 3)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		list := newList()
+		list.Add(1, 2, 3)
+		testJSON(t, list, newList, func(restored lists.List[int]) {
+			t.Helper()
+			assertValues(t, restored, 1, 2, 3)
+		})
+	})
+}
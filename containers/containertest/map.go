@@ -0,0 +1,117 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/maps"
+	"slices"
+	"testing"
+)
+
+// TestMap checks that maps created by the given factory implement the documented semantics of maps.Map.
+// The factory has to return a new empty map on every call.
+// Every key is mapped to a distinct value, so that bidirectional maps can be checked too.
+// Keys and values are compared regardless of their order.
+func TestMap(t *testing.T, newMap func() maps.Map[int, string]) {
+	t.Run("Put", func(t *testing.T) {
+		m := newMap()
+		assertMap(t, m, nil, nil)
+		m.Put(5, "e")
+		m.Put(1, "a")
+		m.Put(3, "c")
+		assertMap(t, m, []int{1, 3, 5}, []string{"a", "c", "e"})
+		m.Put(3, "x")
+		assertMap(t, m, []int{1, 3, 5}, []string{"a", "e", "x"})
+		assertString(t, m)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		m := newMap()
+		if actualValue, found := m.Get(1); actualValue != "" || found {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, found, "", false)
+		}
+		m.Put(1, "a")
+		m.Put(2, "b")
+		// key, expectedValue, expectedFound
+		tests := [][]interface{}{
+			{1, "a", true},
+			{2, "b", true},
+			{3, "", false},
+			{-1, "", false},
+		}
+		for _, test := range tests {
+			if actualValue, found := m.Get(test[0].(int)); actualValue != test[1] || found != test[2] {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, found, test[1], test[2])
+			}
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		m := newMap()
+		m.Remove(1)
+		assertMap(t, m, nil, nil)
+		for key := 1; key <= 10; key++ {
+			m.Put(key, fmt.Sprint(key))
+		}
+		m.Remove(0)
+		m.Remove(11)
+		assertSize(t, m, 10)
+		for key := 2; key <= 10; key += 2 {
+			m.Remove(key)
+			m.Remove(key)
+		}
+		assertMap(t, m, []int{1, 3, 5, 7, 9}, []string{"1", "3", "5", "7", "9"})
+		for key := 1; key <= 10; key++ {
+			if _, found := m.Get(key); found != (key%2 == 1) {
+				t.Errorf("Got %v expected %v", found, key%2 == 1)
+			}
+		}
+		for key := 1; key <= 10; key += 2 {
+			m.Remove(key)
+		}
+		assertMap(t, m, nil, nil)
+		m.Put(1, "a")
+		assertMap(t, m, []int{1}, []string{"a"})
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m := newMap()
+		m.Clear()
+		assertMap(t, m, nil, nil)
+		m.Put(1, "a")
+		m.Put(2, "b")
+		m.Clear()
+		assertMap(t, m, nil, nil)
+		if _, found := m.Get(1); found {
+			t.Errorf("Got %v expected %v", found, false)
+		}
+		m.Put(3, "c")
+		assertMap(t, m, []int{3}, []string{"c"})
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		m := newMap()
+		m.Put(1, "a")
+		m.Put(2, "b")
+		m.Put(3, "c")
+		testJSON(t, m, newMap, func(restored maps.Map[int, string]) {
+			t.Helper()
+			assertMap(t, restored, []int{1, 2, 3}, []string{"a", "b", "c"})
+		})
+	})
+}
+
+// assertMap checks that the map holds exactly the expected keys and values in any order,
+// both given in ascending order.
+func assertMap(t *testing.T, m maps.Map[int, string], expectedKeys []int, expectedValues []string) {
+	t.Helper()
+	assertSortedValues(t, m, expectedValues...)
+	keys := m.Keys()
+	slices.Sort(keys)
+	if actualValue, expectedValue := fmt.Sprint(keys), fmt.Sprint(expectedKeys); actualValue != expectedValue {
+		t.Errorf("Got keys %v expected %v", actualValue, expectedValue)
+	}
+}
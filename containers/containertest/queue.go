@@ -0,0 +1,91 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"github.com/ugurcsen/gods-generic/queues"
+	"testing"
+)
+
+// TestQueue checks that queues created by the given factory implement the documented semantics of queues.Queue.
+// The factory has to return a new empty queue on every call.
+// Values are enqueued in ascending order, so that FIFO queues and min-priority queues dequeue them alike.
+func TestQueue(t *testing.T, newQueue func() queues.Queue[int]) {
+	t.Run("Enqueue", func(t *testing.T) {
+		queue := newQueue()
+		assertValues(t, queue)
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		queue.Enqueue(3)
+		assertValues(t, queue, 1, 2, 3)
+		assertString(t, queue)
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		queue := newQueue()
+		if actualValue, ok := queue.Peek(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 1, true)
+		}
+		assertValues(t, queue, 1, 2)
+	})
+
+	t.Run("Dequeue", func(t *testing.T) {
+		queue := newQueue()
+		if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		queue.Enqueue(3)
+		for _, expectedValue := range []int{1, 2} {
+			if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, expectedValue, true)
+			}
+		}
+		assertValues(t, queue, 3)
+		queue.Enqueue(4)
+		assertValues(t, queue, 3, 4)
+		for _, expectedValue := range []int{3, 4} {
+			if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, expectedValue, true)
+			}
+		}
+		if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		assertValues(t, queue)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		queue := newQueue()
+		queue.Clear()
+		assertValues(t, queue)
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		queue.Clear()
+		assertValues(t, queue)
+		if _, ok := queue.Peek(); ok {
+			t.Errorf("Got %v expected %v", ok, false)
+		}
+		queue.Enqueue(3)
+		assertValues(t, queue, 3)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		queue := newQueue()
+		queue.Enqueue(1)
+		queue.Enqueue(2)
+		queue.Enqueue(3)
+		testJSON(t, queue, newQueue, func(restored queues.Queue[int]) {
+			t.Helper()
+			assertValues(t, restored, 1, 2, 3)
+		})
+	})
+}
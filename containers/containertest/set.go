@@ -0,0 +1,96 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"github.com/ugurcsen/gods-generic/sets"
+	"testing"
+)
+
+// TestSet checks that sets created by the given factory implement the documented semantics of sets.Set.
+// The factory has to return a new empty set on every call.
+// Items are compared regardless of their order.
+func TestSet(t *testing.T, newSet func() sets.Set[int]) {
+	t.Run("Add", func(t *testing.T) {
+		set := newSet()
+		assertSortedValues(t, set)
+		set.Add()
+		set.Add(5)
+		set.Add(1, 3)
+		set.Add(3, 5, 1)
+		assertSortedValues(t, set, 1, 3, 5)
+		assertString(t, set)
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		set := newSet()
+		if actualValue := set.Contains(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := set.Contains(1); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		set.Add(1, 2, 3)
+		// items, expectedContains
+		tests := []struct {
+			items            []int
+			expectedContains bool
+		}{
+			{[]int{1}, true},
+			{[]int{3, 1, 2}, true},
+			{[]int{1, 4}, false},
+			{[]int{0}, false},
+			{[]int{}, true},
+		}
+		for _, test := range tests {
+			if actualValue, expectedValue := set.Contains(test.items...), test.expectedContains; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.items)
+			}
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		set := newSet()
+		set.Remove(1)
+		set.Remove()
+		assertSortedValues(t, set)
+		set.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+		set.Remove(0, 11)
+		assertSize(t, set, 10)
+		set.Remove(2, 4, 6)
+		set.Remove(8, 10, 2)
+		assertSortedValues(t, set, 1, 3, 5, 7, 9)
+		if actualValue := set.Contains(2); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		set.Remove(1, 3, 5, 7, 9)
+		assertSortedValues(t, set)
+		set.Add(2)
+		assertSortedValues(t, set, 2)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		set := newSet()
+		set.Clear()
+		assertSortedValues(t, set)
+		set.Add(1, 2)
+		set.Clear()
+		assertSortedValues(t, set)
+		if actualValue := set.Contains(1); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+		set.Add(3)
+		assertSortedValues(t, set, 3)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		set := newSet()
+		set.Add(3, 1, 2)
+		testJSON(t, set, newSet, func(restored sets.Set[int]) {
+			t.Helper()
+			assertSortedValues(t, restored, 1, 2, 3)
+		})
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package containertest
+
+import (
+	"github.com/ugurcsen/gods-generic/stacks"
+	"testing"
+)
+
+// TestStack checks that stacks created by the given factory implement the documented semantics of stacks.Stack.
+// The factory has to return a new empty stack on every call.
+// Values are expected in LIFO order, i.e. the top of the stack first.
+func TestStack(t *testing.T, newStack func() stacks.Stack[int]) {
+	t.Run("Push", func(t *testing.T) {
+		stack := newStack()
+		assertValues(t, stack)
+		stack.Push(1)
+		stack.Push(2)
+		stack.Push(3)
+		assertValues(t, stack, 3, 2, 1)
+		assertString(t, stack)
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		stack := newStack()
+		if actualValue, ok := stack.Peek(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		stack.Push(1)
+		stack.Push(2)
+		if actualValue, ok := stack.Peek(); actualValue != 2 || !ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 2, true)
+		}
+		assertValues(t, stack, 2, 1)
+	})
+
+	t.Run("Pop", func(t *testing.T) {
+		stack := newStack()
+		if actualValue, ok := stack.Pop(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		stack.Push(1)
+		stack.Push(2)
+		stack.Push(3)
+		for _, expectedValue := range []int{3, 2} {
+			if actualValue, ok := stack.Pop(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, expectedValue, true)
+			}
+		}
+		assertValues(t, stack, 1)
+		stack.Push(4)
+		assertValues(t, stack, 4, 1)
+		for _, expectedValue := range []int{4, 1} {
+			if actualValue, ok := stack.Pop(); actualValue != expectedValue || !ok {
+				t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, expectedValue, true)
+			}
+		}
+		if actualValue, ok := stack.Pop(); actualValue != 0 || ok {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+		}
+		assertValues(t, stack)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		stack := newStack()
+		stack.Clear()
+		assertValues(t, stack)
+		stack.Push(1)
+		stack.Push(2)
+		stack.Clear()
+		assertValues(t, stack)
+		if _, ok := stack.Peek(); ok {
+			t.Errorf("Got %v expected %v", ok, false)
+		}
+		stack.Push(3)
+		assertValues(t, stack, 3)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		stack := newStack()
+		stack.Push(1)
+		stack.Push(2)
+		stack.Push(3)
+		testJSON(t, stack, newStack, func(restored stacks.Stack[int]) {
+			t.Helper()
+			assertValues(t, restored, 3, 2, 1)
+		})
+	})
+}
@@ -5,7 +5,7 @@
 package containers
 
 // EnumerableWithIndex provides functions for ordered containers whose values can be fetched by an index.
-type EnumerableWithIndex[T comparable] interface {
+type EnumerableWithIndex[T any] interface {
 	// Each calls the given function once for each element, passing that element's index and value.
 	Each(func(index int, value T))
 
@@ -31,7 +31,7 @@ type EnumerableWithIndex[T comparable] interface {
 }
 
 // EnumerableWithKey provides functions for ordered containers whose values whose elements are key/value pairs.
-type EnumerableWithKey[K comparable, T comparable] interface {
+type EnumerableWithKey[K comparable, T any] interface {
 	// Each calls the given function once for each element, passing that element's key and value.
 	Each(func(key K, value T))
 
@@ -4,6 +4,17 @@
 
 package containers
 
+import "errors"
+
+// ErrConcurrentModification is the value iterators panic with when their container was modified since the iterator
+// was created or last reset by Begin(), End(), First() or Last(), other than through the iterator itself.
+// Adding, removing or reordering elements modifies a container, replacing the value of an existing element does not.
+// An iterator positioned before the first element (e.g. right after its creation or Begin()) is not affected,
+// Next() moves it to the current first element of the container.
+//
+// Iterators that work on a copy of the values (e.g. of pairing and Fibonacci heaps) are not affected.
+var ErrConcurrentModification = errors.New("containers: container was modified during iteration")
+
 // IteratorWithIndex is stateful iterator for ordered containers whose values can be fetched by an index.
 type IteratorWithIndex[T any] interface {
 	// Next moves the iterator to the next element and returns true if there was a next element in the container.
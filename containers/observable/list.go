@@ -0,0 +1,244 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observable
+
+import (
+	"github.com/ugurcsen/gods-generic/lists"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert List implementation
+var _ lists.List[int] = (*List[int])(nil)
+
+// List wraps a list and reports its modifications, changes are keyed by the index.
+//
+// Removed and Updated changes hold the index before the operation, Added changes hold the index after the operation.
+// Reordering operations (Sort, SortStable, Reverse and Rotate) report every position as Updated.
+type List[T any] struct {
+	list lists.List[T]
+	subject[int, T]
+}
+
+// NewList returns an observable view of the given list.
+// Modifications made directly on the given list are not reported.
+func NewList[T any](list lists.List[T]) *List[T] {
+	return &List[T]{list: list}
+}
+
+// Add appends values (one or more) at the end of the list and emits a single Added event.
+func (list *List[T]) Add(values ...T) {
+	list.insert(list.list.Size(), values, func() { list.list.Add(values...) })
+}
+
+// AddAll appends all elements of the other list at the end of the list and emits a single Added event.
+func (list *List[T]) AddAll(other lists.List[T]) {
+	list.Add(other.Values()...)
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
+// Emits a single Added event.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) {
+	list.insert(index, values, func() { list.list.Insert(index, values...) })
+}
+
+// InsertAll inserts all elements of the other list at specified index position and emits a single Added event.
+// Does not do anything if position is negative or bigger than list's size
+func (list *List[T]) InsertAll(index int, other lists.List[T]) {
+	list.Insert(index, other.Values()...)
+}
+
+// Set replaces the value at specified index and emits Updated, or appends it and emits Added if index is equal to list's size.
+// Does not do anything if position is negative or bigger than list's size.
+func (list *List[T]) Set(index int, value T) {
+	oldValue, found := list.list.Get(index)
+	if !found {
+		list.insert(index, []T{value}, func() { list.list.Set(index, value) })
+		return
+	}
+	list.list.Set(index, value)
+	list.emit(Updated, []Change[int, T]{{Key: index, OldValue: oldValue, NewValue: value}})
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	return list.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list and emits Removed.
+func (list *List[T]) Remove(index int) {
+	oldValue, found := list.list.Get(index)
+	if !found {
+		return
+	}
+	list.list.Remove(index)
+	list.emit(Removed, []Change[int, T]{{Key: index, OldValue: oldValue}})
+}
+
+// RemoveRange removes the elements from index from (inclusive) to index to (exclusive) and emits a single Removed event.
+// Does not do anything if the range is not within bounds of the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.list.Size() || from >= to {
+		return
+	}
+	values := list.list.Values()
+	changes := make([]Change[int, T], 0, to-from)
+	for index := from; index < to; index++ {
+		changes = append(changes, Change[int, T]{Key: index, OldValue: values[index]})
+	}
+	list.list.RemoveRange(from, to)
+	list.emit(Removed, changes)
+}
+
+// RemoveIf removes all elements satisfying the given function, emits a single Removed event
+// and returns the number of removed elements.
+func (list *List[T]) RemoveIf(f func(value T) bool) int {
+	var changes []Change[int, T]
+	index := 0
+	removed := list.list.RemoveIf(func(value T) bool {
+		remove := f(value)
+		if remove {
+			changes = append(changes, Change[int, T]{Key: index, OldValue: value})
+		}
+		index++
+		return remove
+	})
+	list.emit(Removed, changes)
+	return removed
+}
+
+// RetainIf removes all elements not satisfying the given function, emits a single Removed event
+// and returns the number of removed elements.
+func (list *List[T]) RetainIf(f func(value T) bool) int {
+	return list.RemoveIf(func(value T) bool { return !f(value) })
+}
+
+// Contains checks if values (one or more) are present in the list.
+func (list *List[T]) Contains(values ...T) bool {
+	return list.list.Contains(values...)
+}
+
+// ContainsFunc checks if any element satisfies the given function.
+func (list *List[T]) ContainsFunc(f func(value T) bool) bool {
+	return list.list.ContainsFunc(f)
+}
+
+// IndexOf returns index of provided element
+func (list *List[T]) IndexOf(value T) int {
+	return list.list.IndexOf(value)
+}
+
+// IndexOfFunc returns index of the first element satisfying the given function, or -1 if there is none.
+func (list *List[T]) IndexOfFunc(f func(value T) bool) int {
+	return list.list.IndexOfFunc(f)
+}
+
+// LastIndexOf returns index of the last occurrence of provided element, or -1 if there is none.
+func (list *List[T]) LastIndexOf(value T) int {
+	return list.list.LastIndexOf(value)
+}
+
+// BinarySearch searches the value in the list sorted by the given comparator.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (int, bool) {
+	return list.list.BinarySearch(value, comparator)
+}
+
+// Sort sorts values (in-place) using the comparator and emits a single Updated event.
+func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	list.reorder(func() { list.list.Sort(comparator) })
+}
+
+// SortStable sorts values (in-place) keeping the order of equal elements and emits a single Updated event.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
+	list.reorder(func() { list.list.SortStable(comparator) })
+}
+
+// Swap swaps the two values at the specified positions and emits a single Updated event.
+func (list *List[T]) Swap(index1, index2 int) {
+	value1, found1 := list.list.Get(index1)
+	value2, found2 := list.list.Get(index2)
+	if !found1 || !found2 || index1 == index2 {
+		return
+	}
+	list.list.Swap(index1, index2)
+	list.emit(Updated, []Change[int, T]{
+		{Key: index1, OldValue: value1, NewValue: value2},
+		{Key: index2, OldValue: value2, NewValue: value1},
+	})
+}
+
+// Reverse reverses the order of elements (in-place) and emits a single Updated event.
+func (list *List[T]) Reverse() {
+	list.reorder(list.list.Reverse)
+}
+
+// Rotate rotates the elements by k positions to the right (to the left for negative k) and emits a single Updated event.
+func (list *List[T]) Rotate(k int) {
+	if size := list.list.Size(); size == 0 || k%size == 0 {
+		return
+	}
+	list.reorder(func() { list.list.Rotate(k) })
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.list.Empty()
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return list.list.Size()
+}
+
+// Clear removes all elements from the list and emits a single Cleared event holding all removed elements.
+func (list *List[T]) Clear() {
+	values := list.list.Values()
+	changes := make([]Change[int, T], len(values))
+	for index, value := range values {
+		changes[index] = Change[int, T]{Key: index, OldValue: value}
+	}
+	list.list.Clear()
+	list.emit(Cleared, changes)
+}
+
+// Values returns all elements in the wrapped list.
+func (list *List[T]) Values() []T {
+	return list.list.Values()
+}
+
+// String returns a string representation of the wrapped list.
+func (list *List[T]) String() string {
+	return list.list.String()
+}
+
+// insert runs the insertion of values at index and emits Added if the index is valid.
+func (list *List[T]) insert(index int, values []T, insert func()) {
+	if index < 0 || index > list.list.Size() || len(values) == 0 {
+		return
+	}
+	insert()
+	changes := make([]Change[int, T], len(values))
+	for i, value := range values {
+		changes[i] = Change[int, T]{Key: index + i, NewValue: value}
+	}
+	list.emit(Added, changes)
+}
+
+// reorder runs the reordering and emits Updated for every position.
+func (list *List[T]) reorder(reorder func()) {
+	if list.list.Size() < 2 {
+		return
+	}
+	oldValues := list.list.Values()
+	reorder()
+	newValues := list.list.Values()
+	changes := make([]Change[int, T], len(newValues))
+	for index := range newValues {
+		changes[index] = Change[int, T]{Key: index, OldValue: oldValues[index], NewValue: newValues[index]}
+	}
+	list.emit(Updated, changes)
+}
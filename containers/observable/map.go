@@ -0,0 +1,86 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observable
+
+import "github.com/ugurcsen/gods-generic/maps"
+
+// Assert Map implementation
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
+
+// Map wraps a map and reports its modifications, changes are keyed by the map keys.
+type Map[K comparable, T any] struct {
+	m maps.Map[K, T]
+	subject[K, T]
+}
+
+// NewMap returns an observable view of the given map.
+// Modifications made directly on the given map are not reported.
+func NewMap[K comparable, T any](m maps.Map[K, T]) *Map[K, T] {
+	return &Map[K, T]{m: m}
+}
+
+// Put inserts element into the map and emits Added for a new key or Updated for an existing key.
+func (m *Map[K, T]) Put(key K, value T) {
+	oldValue, found := m.m.Get(key)
+	m.m.Put(key, value)
+	if found {
+		m.emit(Updated, []Change[K, T]{{Key: key, OldValue: oldValue, NewValue: value}})
+	} else {
+		m.emit(Added, []Change[K, T]{{Key: key, NewValue: value}})
+	}
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, T]) Get(key K) (value T, found bool) {
+	return m.m.Get(key)
+}
+
+// Remove removes the element from the map by key and emits Removed if the key was present.
+func (m *Map[K, T]) Remove(key K) {
+	oldValue, found := m.m.Get(key)
+	if !found {
+		return
+	}
+	m.m.Remove(key)
+	m.emit(Removed, []Change[K, T]{{Key: key, OldValue: oldValue}})
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, T]) Empty() bool {
+	return m.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, T]) Size() int {
+	return m.m.Size()
+}
+
+// Keys returns all keys of the wrapped map.
+func (m *Map[K, T]) Keys() []K {
+	return m.m.Keys()
+}
+
+// Values returns all values of the wrapped map.
+func (m *Map[K, T]) Values() []T {
+	return m.m.Values()
+}
+
+// Clear removes all elements from the map and emits a single Cleared event holding all removed pairs.
+func (m *Map[K, T]) Clear() {
+	keys := m.m.Keys()
+	changes := make([]Change[K, T], 0, len(keys))
+	for _, key := range keys {
+		value, _ := m.m.Get(key)
+		changes = append(changes, Change[K, T]{Key: key, OldValue: value})
+	}
+	m.m.Clear()
+	m.emit(Cleared, changes)
+}
+
+// String returns a string representation of the wrapped map.
+func (m *Map[K, T]) String() string {
+	return m.m.String()
+}
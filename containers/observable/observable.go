@@ -0,0 +1,123 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package observable wraps maps, sets and lists so that every modification is reported to listeners.
+//
+// Each modifying call emits at most one event. Batch operations (e.g. adding many values at once, RemoveIf or Clear)
+// emit a single event holding all changes, calls that do not modify the container emit nothing.
+//
+// Listeners are called synchronously after the wrapped container has been modified.
+// Subscriptions deliver the same events over channels, a send blocks while the channel's buffer is full.
+//
+// Wrappers are not thread safe, the same as the wrapped containers.
+// Listeners and subscriptions have to be registered and cancelled by the goroutine that modifies the container.
+package observable
+
+// EventType is the kind of modification reported by an event.
+type EventType int
+
+const (
+	// Added reports new entries, only NewValue of the changes is set.
+	Added EventType = iota
+	// Removed reports removed entries, only OldValue of the changes is set.
+	Removed
+	// Updated reports replaced entries, both OldValue and NewValue of the changes are set.
+	Updated
+	// Cleared reports a cleared container, the changes hold all removed entries in OldValue.
+	Cleared
+)
+
+// String returns the name of the event type.
+func (eventType EventType) String() string {
+	switch eventType {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Updated:
+		return "Updated"
+	case Cleared:
+		return "Cleared"
+	}
+	return "Unknown"
+}
+
+// Change holds a single modified entry.
+//
+// Key is the key for maps, the element for sets and the index for lists.
+type Change[K any, T any] struct {
+	Key      K
+	OldValue T
+	NewValue T
+}
+
+// Event holds all changes made by a single operation.
+type Event[K any, T any] struct {
+	Type    EventType
+	Changes []Change[K, T]
+}
+
+// Listener is called synchronously with every event.
+type Listener[K any, T any] func(event Event[K, T])
+
+type listener[K any, T any] struct {
+	notify    Listener[K, T]
+	cancelled bool
+}
+
+// subject keeps track of listeners and subscriptions and dispatches events to them.
+type subject[K any, T any] struct {
+	listeners []*listener[K, T]
+}
+
+// Listen registers the listener and returns a function which unregisters it.
+// Listeners are called in the order of registration.
+func (subject *subject[K, T]) Listen(notify Listener[K, T]) (cancel func()) {
+	l := &listener[K, T]{notify: notify}
+	subject.listeners = append(subject.listeners, l)
+	return func() { subject.remove(l) }
+}
+
+// Subscribe returns a channel receiving every event and a function which unsubscribes and closes the channel.
+// Buffer is the capacity of the channel, sending blocks while the buffer is full.
+func (subject *subject[K, T]) Subscribe(buffer int) (events <-chan Event[K, T], cancel func()) {
+	channel := make(chan Event[K, T], buffer)
+	l := &listener[K, T]{notify: func(event Event[K, T]) { channel <- event }}
+	subject.listeners = append(subject.listeners, l)
+	return channel, func() {
+		if !l.cancelled {
+			subject.remove(l)
+			close(channel)
+		}
+	}
+}
+
+// remove unregisters the listener.
+// The slice is copied so that a dispatch in progress keeps iterating over the listeners it started with.
+func (subject *subject[K, T]) remove(l *listener[K, T]) {
+	if l.cancelled {
+		return
+	}
+	l.cancelled = true
+	listeners := make([]*listener[K, T], 0, len(subject.listeners)-1)
+	for _, other := range subject.listeners {
+		if other != l {
+			listeners = append(listeners, other)
+		}
+	}
+	subject.listeners = listeners
+}
+
+// emit dispatches an event with the given changes, nothing is dispatched if there are no changes.
+func (subject *subject[K, T]) emit(eventType EventType, changes []Change[K, T]) {
+	if len(changes) == 0 {
+		return
+	}
+	event := Event[K, T]{Type: eventType, Changes: changes}
+	for _, l := range subject.listeners {
+		if !l.cancelled {
+			l.notify(event)
+		}
+	}
+}
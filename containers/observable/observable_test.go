@@ -0,0 +1,304 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observable
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/lists"
+	"github.com/ugurcsen/gods-generic/lists/arraylist"
+	"github.com/ugurcsen/gods-generic/lists/doublylinkedlist"
+	"github.com/ugurcsen/gods-generic/lists/singlylinkedlist"
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/maps/hashmap"
+	"github.com/ugurcsen/gods-generic/maps/linkedhashmap"
+	"github.com/ugurcsen/gods-generic/maps/treemap"
+	"github.com/ugurcsen/gods-generic/sets"
+	"github.com/ugurcsen/gods-generic/sets/hashset"
+	"github.com/ugurcsen/gods-generic/sets/treeset"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// recorder collects events of a listener
+type recorder[K any, T any] struct {
+	events []Event[K, T]
+}
+
+func (r *recorder[K, T]) listen(event Event[K, T]) {
+	r.events = append(r.events, event)
+}
+
+// last returns the formatted last event and clears the recorded events, empty string if none was recorded
+func (r *recorder[K, T]) last(t *testing.T) string {
+	t.Helper()
+	if len(r.events) > 1 {
+		t.Errorf("Got %v events expected at most %v", len(r.events), 1)
+	}
+	if len(r.events) == 0 {
+		return ""
+	}
+	event := r.events[len(r.events)-1]
+	r.events = nil
+	return fmt.Sprintf("%v%v", event.Type, event.Changes)
+}
+
+func TestEventTypeString(t *testing.T) {
+	tests := [][]interface{}{
+		{Added, "Added"},
+		{Removed, "Removed"},
+		{Updated, "Updated"},
+		{Cleared, "Cleared"},
+		{EventType(10), "Unknown"},
+	}
+	for _, test := range tests {
+		if actualValue, expectedValue := test[0].(EventType).String(), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	m := NewMap[int, string](treemap.NewWithNumberComparator[string]())
+	r := &recorder[int, string]{}
+	m.Listen(r.listen)
+
+	m.Put(1, "a")
+	if actualValue, expectedValue := r.last(t), "Added[{1  a}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(1, "b")
+	if actualValue, expectedValue := r.last(t), "Updated[{1 a b}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(2, "c")
+	r.last(t)
+	m.Remove(3)
+	if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Remove(1)
+	if actualValue, expectedValue := r.last(t), "Removed[{1 b }]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(1, "d")
+	r.last(t)
+	m.Clear()
+	if actualValue, expectedValue := r.last(t), "Cleared[{1 d } {2 c }]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapWrapsLinkedHashMap(t *testing.T) {
+	inner := linkedhashmap.New[string, int]()
+	m := NewMap[string, int](inner)
+	r := &recorder[string, int]{}
+	m.Listen(r.listen)
+	m.Put("b", 1)
+	m.Put("a", 2)
+	r.events = nil
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.String(), inner.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	if actualValue, expectedValue := r.last(t), "Cleared[{b 1 0} {a 2 0}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := inner.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSet(t *testing.T) {
+	set := NewSet[int](hashset.New[int]())
+	r := &recorder[int, int]{}
+	set.Listen(r.listen)
+
+	set.Add(1, 2, 1)
+	if actualValue, expectedValue := r.last(t), "Added[{1 0 1} {2 0 2}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add(2, 3)
+	if actualValue, expectedValue := r.last(t), "Added[{3 0 3}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add(1, 2)
+	if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Remove(3, 4, 3)
+	if actualValue, expectedValue := r.last(t), "Removed[{3 3 0}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	if actualValue, expectedValue := len(r.events), 1; actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := r.events[0].Type, Cleared; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(r.events[0].Changes), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetWrapsTreeSetWithComparator(t *testing.T) {
+	caseInsensitive := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+	set := NewSet[string](treeset.NewWith(caseInsensitive))
+	r := &recorder[string, string]{}
+	set.Listen(r.listen)
+
+	set.Add("a", "A", "b")
+	if actualValue, expectedValue := r.last(t), "Added[{a  a} {b  b}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Remove("B", "b", "c")
+	if actualValue, expectedValue := r.last(t), "Removed[{B B }]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestList(t *testing.T) {
+	for _, inner := range []lists.List[string]{arraylist.New[string](), singlylinkedlist.New[string](), doublylinkedlist.New[string]()} {
+		list := NewList[string](inner)
+		r := &recorder[int, string]{}
+		list.Listen(r.listen)
+
+		list.Add("a", "b")
+		if actualValue, expectedValue := r.last(t), "Added[{0  a} {1  b}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Insert(1, "c", "d")
+		if actualValue, expectedValue := r.last(t), "Added[{1  c} {2  d}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Insert(10, "x")
+		list.Add()
+		if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Set(0, "e")
+		if actualValue, expectedValue := r.last(t), "Updated[{0 a e}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Set(4, "f")
+		if actualValue, expectedValue := r.last(t), "Added[{4  f}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		// e c d b f
+		list.Swap(0, 4)
+		if actualValue, expectedValue := r.last(t), "Updated[{0 e f} {4 f e}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		// f c d b e
+		list.RemoveIf(func(value string) bool { return value == "c" || value == "b" })
+		if actualValue, expectedValue := r.last(t), "Removed[{1 c } {3 b }]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		// f d e
+		list.Sort(utils.StringComparator)
+		if actualValue, expectedValue := r.last(t), "Updated[{0 f d} {1 d e} {2 e f}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		// d e f
+		list.Rotate(3)
+		if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Rotate(-1)
+		if actualValue, expectedValue := r.last(t), "Updated[{0 d e} {1 e f} {2 f d}]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		// e f d
+		list.RemoveRange(0, 2)
+		if actualValue, expectedValue := r.last(t), "Removed[{0 e } {1 f }]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Remove(1)
+		if actualValue, expectedValue := r.last(t), ""; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list.Clear()
+		if actualValue, expectedValue := r.last(t), "Cleared[{0 d }]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := inner.Size(), 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestListenCancel(t *testing.T) {
+	m := NewMap[int, int](treemap.NewWithNumberComparator[int]())
+	var order []string
+	var cancelSecond func()
+	cancelFirst := m.Listen(func(event Event[int, int]) {
+		order = append(order, "first")
+		cancelSecond() // cancelled listeners are not called, even during a dispatch
+	})
+	cancelSecond = m.Listen(func(event Event[int, int]) { order = append(order, "second") })
+	m.Put(1, 1)
+	if actualValue, expectedValue := fmt.Sprint(order), "[first]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	cancelFirst()
+	cancelFirst()
+	m.Put(2, 2)
+	if actualValue, expectedValue := fmt.Sprint(order), "[first]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	m := NewMap[string, int](treemap.NewWithStringComparator[int]())
+	events, cancel := m.Subscribe(0)
+	done := make(chan []string)
+	go func() {
+		var received []string
+		for event := range events {
+			received = append(received, fmt.Sprintf("%v%v", event.Type, event.Changes))
+		}
+		done <- received
+	}()
+	m.Put("a", 1)
+	m.Put("a", 2)
+	m.Remove("a")
+	cancel()
+	cancel()
+	m.Put("b", 3)
+	if actualValue, expectedValue := fmt.Sprint(<-done), "[Added[{a 0 1}] Updated[{a 1 2}] Removed[{a 2 0}]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListConformance(t *testing.T) {
+	containertest.TestList(t, func() lists.List[int] { return NewList[int](arraylist.New[int]()) })
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return NewMap[int, string](hashmap.New[int, string]()) })
+}
+
+func TestSetConformance(t *testing.T) {
+	containertest.TestSet(t, func() sets.Set[int] { return NewSet[int](hashset.New[int]()) })
+}
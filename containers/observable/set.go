@@ -0,0 +1,93 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package observable
+
+import "github.com/ugurcsen/gods-generic/sets"
+
+// Assert Set implementation
+var _ sets.Set[int] = (*Set[int])(nil)
+
+// Set wraps a set and reports its modifications.
+// Changes are keyed by the elements, the element is also held in NewValue when added and in OldValue when removed.
+type Set[T comparable] struct {
+	set sets.Set[T]
+	subject[T, T]
+}
+
+// NewSet returns an observable view of the given set.
+// Modifications made directly on the given set are not reported.
+func NewSet[T comparable](set sets.Set[T]) *Set[T] {
+	return &Set[T]{set: set}
+}
+
+// Add adds the items (one or more) to the set and emits a single Added event for the items that were not present.
+// Items are added one by one, so that an item the wrapped set considers equal to an earlier one is not reported.
+func (set *Set[T]) Add(items ...T) {
+	var changes []Change[T, T]
+	for _, item := range items {
+		if set.set.Contains(item) {
+			continue
+		}
+		set.set.Add(item)
+		if set.set.Contains(item) {
+			changes = append(changes, Change[T, T]{Key: item, NewValue: item})
+		}
+	}
+	set.emit(Added, changes)
+}
+
+// Remove removes the items (one or more) from the set and emits a single Removed event for the items that were present.
+// Items are removed one by one, so that an item the wrapped set considers equal to an earlier one is not reported.
+func (set *Set[T]) Remove(items ...T) {
+	var changes []Change[T, T]
+	for _, item := range items {
+		if !set.set.Contains(item) {
+			continue
+		}
+		set.set.Remove(item)
+		if !set.set.Contains(item) {
+			changes = append(changes, Change[T, T]{Key: item, OldValue: item})
+		}
+	}
+	set.emit(Removed, changes)
+}
+
+// Contains check if items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(items ...T) bool {
+	return set.set.Contains(items...)
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) Empty() bool {
+	return set.set.Empty()
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return set.set.Size()
+}
+
+// Clear clears all values in the set and emits a single Cleared event holding all removed elements.
+func (set *Set[T]) Clear() {
+	values := set.set.Values()
+	changes := make([]Change[T, T], len(values))
+	for i, value := range values {
+		changes[i] = Change[T, T]{Key: value, OldValue: value}
+	}
+	set.set.Clear()
+	set.emit(Cleared, changes)
+}
+
+// Values returns all items in the wrapped set.
+func (set *Set[T]) Values() []T {
+	return set.set.Values()
+}
+
+// String returns a string representation of the wrapped set.
+func (set *Set[T]) String() string {
+	return set.set.String()
+}
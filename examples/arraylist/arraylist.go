@@ -28,4 +28,16 @@ func main() {
 	_ = list.Size()                       // 0
 	list.Add("a")                         // ["a"]
 	list.Clear()                          // []
+
+	// Range and bulk operations
+	list.Add("a", "b", "c", "d", "e")                              // ["a","b","c","d","e"]
+	list.RemoveRange(1, 3)                                         // ["a","d","e"]
+	list.Rotate(1)                                                 // ["e","a","d"]
+	list.Reverse()                                                 // ["d","a","e"]
+	list.AddAll(arraylist.New("b"))                                // ["d","a","e","b"]
+	list.InsertAll(1, list.SubList(0, 2))                          // ["d","d","a","a","e","b"]
+	_ = list.LastIndexOf("a")                                      // 3
+	list.RetainIf(func(value string) bool { return value != "d" }) // ["a","a","e","b"]
+	list.EnsureCapacity(100)                                       // capacity at least 100
+	list.TrimToSize()                                              // capacity of 4
 }
@@ -37,4 +37,16 @@ func main() {
 	heap.Push(3)                                    // 3, 2
 	heap.Push(1)                                    // 3, 2, 1
 	heap.Values()                                   // 3, 2, 1
+
+	// Heapify, bulk operations and d-ary heaps
+	heap = binaryheap.NewFrom([]int{5, 1, 4, 2, 3}, utils.NumberComparator[int]) // 1, 2, 3, 4, 5 (built in O(n))
+	_ = heap.PushPop(0)                                                          // 0 (nothing pushed, since 0 would be popped right away)
+	_ = heap.PushPop(6)                                                          // 1 (heap holds 2, 3, 4, 5, 6)
+	_, _ = heap.Replace(0)                                                       // 2, true (heap holds 0, 3, 4, 5, 6)
+	index := heap.IndexOfFunc(func(value int) bool { return value == 4 })        // position of 4 in the heap's array
+	_, _ = heap.Remove(index)                                                    // 4, true (heap holds 0, 3, 5, 6)
+	other := binaryheap.NewWithArity(4, utils.NumberComparator[int])             // empty (4-ary min-heap)
+	other.Push(7, 1)                                                             // 1, 7
+	heap.Merge(other)                                                            // 0, 1, 3, 5, 6, 7 (other is unchanged)
+	_, _ = heap.Pop()                                                            // 0, true
 }
@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/ugurcsen/gods-generic/stacks/boundedstack"
+
+// BoundedStackExample to demonstrate basic usage of BoundedStack
+func main() {
+	stack := boundedstack.New[int](3, boundedstack.DropOldest) // empty, holds at most 3 elements
+	stack.Push(1)                                              // 1
+	stack.Push(2)                                              // 1, 2
+	stack.Push(3)                                              // 1, 2, 3
+	stack.Full()                                               // true
+	stack.Push(4)                                              // 2, 3, 4 (oldest element dropped)
+	stack.Values()                                             // 4, 3, 2 (LIFO order)
+	_, _ = stack.Pop()                                         // 4, true
+
+	strict := boundedstack.New[int](1, boundedstack.Error) // empty, holds at most 1 element
+	_ = strict.TryPush(1)                                  // nil
+	_ = strict.TryPush(2)                                  // boundedstack.ErrFull (2 is discarded)
+	strict.Push(3)                                         // 1 (3 is discarded, Push does not report errors)
+}
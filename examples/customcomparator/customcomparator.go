@@ -7,6 +7,7 @@ package main
 import (
 	"fmt"
 	"github.com/ugurcsen/gods-generic/sets/treeset"
+	"github.com/ugurcsen/gods-generic/utils"
 )
 
 // User model (id and name)
@@ -42,4 +43,13 @@ func main() {
 	set.Add(User{4, "Fourth"})
 
 	fmt.Println(set) // {1 First}, {2 Second}, {3 Third}, {4 Fourth}
+
+	// Comparator combinators (sort by names, then by IDs in reverse order)
+	byName := utils.ThenComparing(
+		utils.ComparingBy(func(u User) string { return u.name }, utils.StringComparator),
+		utils.Reverse(utils.ComparingBy(func(u User) int { return u.id }, utils.OrderedComparator[int])),
+	)
+	set = treeset.NewWith(byName, set.Values()...)
+
+	fmt.Println(set) // {1 First}, {4 Fourth}, {2 Second}, {3 Third}
 }
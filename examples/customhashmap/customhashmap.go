@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/ugurcsen/gods-generic/maps/customhashmap"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// CustomHashMapExample to demonstrate basic usage of CustomHashMap
+func main() {
+	m := customhashmap.New[string, string](utils.CaseInsensitiveStringHasher{}) // empty (keys are case-insensitive strings)
+	m.Put("Content-Type", "text/plain")                                         // Content-Type->text/plain
+	m.Put("content-type", "text/html")                                          // Content-Type->text/html (same key)
+	m.Put("Accept", "*/*")                                                      // Content-Type->text/html, Accept->*/* (random order)
+	_, _ = m.Get("CONTENT-TYPE")                                                // text/html, true
+	_, _ = m.Get("Host")                                                        // nil, false
+	_ = m.Keys()                                                                // []string{"Content-Type", "Accept"} (random order)
+	m.Remove("accept")                                                          // Content-Type->text/html
+	m.Clear()                                                                   // empty
+
+	// byte slices are not comparable, but can be keys with the BytesHasher
+	b := customhashmap.New[[]byte, int](utils.BytesHasher{}) // empty (keys are byte slices)
+	b.Put([]byte("a"), 1)                                    // a->1
+	_, _ = b.Get([]byte("a"))                                // 1, true
+}
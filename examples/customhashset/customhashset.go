@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/ugurcsen/gods-generic/sets/customhashset"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// CustomHashSetExample to demonstrate basic usage of CustomHashSet
+func main() {
+	set := customhashset.New[[]byte](utils.BytesHasher{}) // empty (items are byte slices)
+	set.Add([]byte("a"))                                  // a
+	set.Add([]byte("b"), []byte("a"))                     // a, b (random order, duplicates ignored)
+	set.Contains([]byte("a"))                             // true
+	set.Remove([]byte("a"))                               // b
+	_ = set.Values()                                      // [][]byte{[]byte("b")}
+	set.Clear()                                           // empty
+
+	words := customhashset.New[string](utils.CaseInsensitiveStringHasher{}, "Go", "GO", "go") // Go
+	words.Size()                                                                              // 1
+}
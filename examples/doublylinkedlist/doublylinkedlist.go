@@ -28,4 +28,15 @@ func main() {
 	_ = list.Size()                       // 0
 	list.Add("a")                         // ["a"]
 	list.Clear()                          // []
+
+	// Element handles
+	b := list.PushBack("b")    // ["b"]
+	a := list.PushFront("a")   // ["a","b"]
+	list.InsertAfter("c", b)   // ["a","b","c"]
+	list.MoveToBack(a)         // ["b","c","a"]
+	list.MoveBefore(a, b)      // ["a","b","c"]
+	list.RemoveElement(b)      // ["a","c"]
+	other := dll.New("x", "y") // ["x","y"]
+	list.Splice(other, a)      // ["x","y","a","c"], other is empty
+	_ = list.Front().Value     // "x"
 }
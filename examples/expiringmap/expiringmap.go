@@ -0,0 +1,25 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/ugurcsen/gods-generic/maps/expiringmap"
+)
+
+// ExpiringMapExample to demonstrate basic usage of ExpiringMap
+func main() {
+	m := expiringmap.New[string, int](time.Minute)             // empty (entries expire after a minute)
+	m.OnExpire(func(key string, value int) { /* notified */ }) // called for every expired entry
+	m.Put("a", 1)                                              // a->1 (expires in a minute)
+	m.PutWithTTL("b", 2, time.Hour)                            // a->1, b->2 (b expires in an hour)
+	m.PutWithTTL("c", 3, 0)                                    // a->1, b->2, c->3 (c never expires)
+	_, _ = m.Get("a")                                          // 1, true (nil, false once expired)
+	_, _ = m.ExpiresAt("b")                                    // time in an hour, true
+	_ = m.Sweep()                                              // 0 (number of evicted expired entries)
+	m.StartJanitor(10 * time.Second)                           // sweeps every 10 seconds in the background
+	m.StopJanitor()                                            // stops sweeping
+}
@@ -18,6 +18,10 @@ func main() {
 	_, _ = m.Get(3)                     // nil, false
 	_ = m.Values()                      // []string{"a", "b"} (random order)
 	_ = m.Keys()                        // []int{1, 2} (random order)
+	_ = m.PutStrict(3, "a")             // maps.ErrValueExists, unchanged
+	inverse := m.Inverse()              // a->1, b->2 (random order)
+	inverse.Put("c", 3)                 // 1->a, 2->b, 3->c (random order)
+	m.Remove(3)                         // 1->a, 2->b (random order)
 	m.Remove(1)                         // 2->b
 	m.Clear()                           // empty
 	m.Empty()                           // true
@@ -0,0 +1,26 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/ugurcsen/gods-generic/trees/minmaxheap"
+
+// MinMaxHeapExample to demonstrate basic usage of MinMaxHeap
+func main() {
+	heap := minmaxheap.NewWithNumberComparator[int]() // empty
+	heap.Push(2)                                      // 2
+	heap.Push(3, 1, 4)                                // 1, 2, 3, 4
+	heap.Values()                                     // 1, 2, 3, 4 (ascending order)
+	_, _ = heap.PeekMin()                             // 1,true
+	_, _ = heap.PeekMax()                             // 4,true
+	_, _ = heap.PopMin()                              // 1, true
+	_, _ = heap.PopMax()                              // 4, true
+	_, _ = heap.PopMax()                              // 3, true
+	_, _ = heap.PopMin()                              // 2, true
+	_, _ = heap.PopMin()                              // nil, false (nothing to pop)
+	heap.Push(1)                                      // 1
+	heap.Clear()                                      // empty
+	heap.Empty()                                      // true
+	heap.Size()                                       // 0
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/ugurcsen/gods-generic/stacks/minmaxstack"
+
+// MinMaxStackExample to demonstrate basic usage of MinMaxStack
+func main() {
+	stack := minmaxstack.NewWithNumberComparator[int]() // empty
+	stack.Push(3)                                       // 3
+	stack.Push(1)                                       // 3, 1
+	stack.Push(5)                                       // 3, 1, 5
+	stack.Values()                                      // 5, 1, 3 (LIFO order)
+	_, _ = stack.Min()                                  // 1, true
+	_, _ = stack.Max()                                  // 5, true
+	_, _ = stack.Pop()                                  // 5, true
+	_, _ = stack.Max()                                  // 3, true
+	_, _ = stack.Pop()                                  // 1, true
+	_, _ = stack.Min()                                  // 3, true
+	stack.Clear()                                       // empty
+	_, _ = stack.Min()                                  // 0, false (nothing to peek)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/containers/observable"
+	"github.com/ugurcsen/gods-generic/maps/treemap"
+)
+
+// ObservableExample to demonstrate basic usage of observable containers
+func main() {
+	m := observable.NewMap[string, int](treemap.NewWithStringComparator[int]())
+	cancel := m.Listen(func(event observable.Event[string, int]) {
+		fmt.Println(event.Type, event.Changes)
+	})
+	m.Put("a", 1) // Added [{a 0 1}]
+	m.Put("a", 2) // Updated [{a 1 2}]
+	m.Put("b", 3) // Added [{b 0 3}]
+	m.Remove("c") // no event
+	m.Clear()     // Cleared [{a 2 0} {b 3 0}]
+	cancel()
+
+	events, unsubscribe := m.Subscribe(1)
+	m.Put("c", 4)
+	fmt.Println(<-events) // {Added [{c 0 4}]}
+	unsubscribe()         // closes the channel
+}
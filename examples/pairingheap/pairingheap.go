@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "github.com/ugurcsen/gods-generic/trees/pairingheap"
+
+// PairingHeapExample to demonstrate basic usage of PairingHeap
+func main() {
+	heap := pairingheap.NewWithNumberComparator[int]() // empty (min-heap)
+	heap.Push(5, 3)                                    // 3, 5
+	node := heap.Insert(4)                             // 3, 4, 5
+	_ = node.Value()                                   // 4
+	heap.DecreaseKey(node, 1)                          // 1, 3, 5
+	_, _ = heap.Peek()                                 // 1,true
+	heap.Delete(node)                                  // 3, 5
+
+	other := pairingheap.NewWithNumberComparator[int]()
+	other.Push(2, 6)  // 2, 6
+	heap.Meld(other)  // 2, 3, 5, 6 (other is empty)
+	heap.Values()     // 2, 3, 5, 6
+	_, _ = heap.Pop() // 2, true
+	_, _ = heap.Pop() // 3, true
+	heap.Clear()      // empty
+	heap.Empty()      // true
+	heap.Size()       // 0
+}
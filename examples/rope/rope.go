@@ -0,0 +1,29 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/lists/rope"
+)
+
+// RopeExample to demonstrate basic usage of Rope
+func main() {
+	list := rope.New[int](1, 2, 3, 4, 5) // [1,2,3,4,5]
+	list.Insert(2, 9)                    // [1,2,9,3,4,5]
+	list.RemoveRange(1, 3)               // [1,3,4,5]
+	_, _ = list.Get(1)                   // 3,true
+	left, right := list.Split(2)         // [1,3] [4,5], list is not modified
+	right.Concat(left)                   // [4,5,1,3]
+	_ = list.Slice(1, 3)                 // [3,4]
+
+	text := rope.NewString("Hello, world!")
+	text.Insert(7, "big ")         // "Hello, big world!"
+	text.Delete(5, 10)             // "Hello world!"
+	_, _ = text.Get(6)             // 'w',true
+	_ = text.Slice(6, 11).String() // "world"
+	fmt.Println(text)              // Hello world!
+}
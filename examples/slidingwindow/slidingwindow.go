@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/ugurcsen/gods-generic/queues/slidingwindow"
+)
+
+// SlidingWindowExample to demonstrate basic usage of SlidingWindow
+func main() {
+	window := slidingwindow.New[int](3) // empty (holds the 3 most recent values)
+	window.Add(4)                       // 4
+	window.Add(2)                       // 4, 2
+	window.Add(6)                       // 4, 2, 6
+	window.Add(5)                       // 2, 6, 5 (4 evicted)
+	_ = window.Sum()                    // 13
+	_ = window.Mean()                   // 4.333333333333333
+	_ = window.Variance()               // 2.888888888888889
+	_, _ = window.Min()                 // 2, true
+	_, _ = window.Max()                 // 6, true
+
+	start := time.Now()
+	timed := slidingwindow.NewWithDuration[float64](100, time.Minute) // values of the last minute, at most 100
+	timed.AddAt(start, 1.5)                                           // 1.5
+	timed.AddAt(start.Add(30*time.Second), 2.5)                       // 1.5, 2.5
+	timed.AddAt(start.Add(time.Minute), 3.5)                          // 2.5, 3.5 (1.5 evicted)
+	_ = timed.Advance(start.Add(2 * time.Minute))                     // 2 (evicted values), empty
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/ugurcsen/gods-generic/maps/sortedslicemap"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// SortedSliceMapExample to demonstrate basic usage of SortedSliceMap
+func main() {
+	m := sortedslicemap.NewWithNumberComparator[string]() // empty (keys are of type int)
+	m.Put(1, "x")                                         // 1->x
+	m.Put(2, "b")                                         // 1->x, 2->b (in order)
+	m.Put(1, "a")                                         // 1->a, 2->b (in order)
+	_, _ = m.Get(2)                                       // b, true
+	_, _ = m.Get(3)                                       // nil, false
+	_ = m.Values()                                        // []string{"a", "b"} (in order)
+	_ = m.Keys()                                          // []int{1, 2} (in order)
+	_, _, _ = m.Floor(3)                                  // 2, b, true
+	_, _, _ = m.Higher(1)                                 // 2, b, true
+	m.Remove(1)                                           // 2->b
+	m.Clear()                                             // empty
+	m.Empty()                                             // true
+	m.Size()                                              // 0
+
+	// bulk construction from keys in ascending order, in O(n)
+	m = sortedslicemap.FromSorted(utils.NumberComparator[int], []int{1, 2, 3}, []string{"a", "b", "c"})
+	_, _, _ = m.Ceiling(2) // 2, b, true
+}
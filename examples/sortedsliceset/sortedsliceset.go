@@ -0,0 +1,33 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/ugurcsen/gods-generic/sets/sortedsliceset"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// SortedSliceSetExample to demonstrate basic usage of SortedSliceSet
+func main() {
+	set := sortedsliceset.NewWithNumberComparator() // empty (keys are of type int)
+	set.Add(1)                                      // 1
+	set.Add(2, 2, 3, 4, 5)                          // 1, 2, 3, 4, 5 (in order, duplicates ignored)
+	set.Remove(4)                                   // 1, 2, 3, 5 (in order)
+	set.Remove(2, 3)                                // 1, 5 (in order)
+	set.Contains(1)                                 // true
+	set.Contains(1, 5)                              // true
+	set.Contains(1, 6)                              // false
+	_, _ = set.Floor(4)                             // 1, true
+	_, _ = set.Ceiling(4)                           // 5, true
+	_, _ = set.Lower(5)                             // 1, true
+	_ = set.Values()                                // []int{1,5} (in order)
+	set.Clear()                                     // empty
+	set.Empty()                                     // true
+	set.Size()                                      // 0
+
+	// bulk construction from values in ascending order, in O(n)
+	set = sortedsliceset.FromSorted(utils.NumberComparator[int], []int{1, 2, 3})
+	_, _ = set.Max() // 3, true
+}
@@ -20,4 +20,22 @@ func main() {
 	m.Clear()                                      // empty
 	m.Empty()                                      // true
 	m.Size()                                       // 0
+
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(5, "e")
+	_, _, _ = m.Floor(3)     // 3, c, true (largest key <= 3)
+	_, _, _ = m.Ceiling(4)   // 5, e, true (smallest key >= 4)
+	_, _, _ = m.Lower(3)     // 1, a, true (largest key < 3)
+	_, _, _ = m.Higher(5)    // 0, "", false (smallest key > 5)
+	_, _, _ = m.FirstEntry() // 1, a, true
+	_, _, _ = m.LastEntry()  // 5, e, true
+	_, _, _ = m.PollFirst()  // 1, a, true (3->c, 5->e)
+	_, _, _ = m.PollLast()   // 5, e, true (3->c)
+	_ = m.DescendingKeys()   // []int{3}
+	_ = m.DescendingMap()    // new map in descending key order
+
+	m.Put(7, "g")
+	left, right := m.Split(5) // 3->c and 7->g (m is empty)
+	left.Union(right)         // 3->c, 7->g (right is empty)
 }
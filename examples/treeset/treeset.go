@@ -17,6 +17,16 @@ func main() {
 	set.Contains(1, 5)                       // true
 	set.Contains(1, 6)                       // false
 	_ = set.Values()                         // []int{1,5} (in order)
+	_, _ = set.Floor(4)                      // 1, true (largest item <= 4)
+	_, _ = set.Ceiling(4)                    // 5, true (smallest item >= 4)
+	_, _ = set.Lower(1)                      // 0, false (largest item < 1)
+	_, _ = set.Higher(1)                     // 5, true (smallest item > 1)
+	_ = set.Descending()                     // new set in descending order: 5, 1
+	_, _ = set.PollFirst()                   // 1, true (5)
+	_, _ = set.PollLast()                    // 5, true (empty)
+	set.Add(1, 2, 3, 4)                      // 1, 2, 3, 4
+	left, right := set.Split(3)              // 1, 2 and 3, 4 (set is empty)
+	left.UnionWith(right)                    // 1, 2, 3, 4 (in place, right is empty)
 	set.Clear()                              // empty
 	set.Empty()                              // true
 	set.Size()                               // 0
@@ -0,0 +1,66 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arena implements a node allocator for the pointer based containers.
+//
+// Values are allocated in chunks of a fixed size, so that the garbage collector tracks one object per chunk instead
+// of one object per value. Released values are kept in a free list and handed out again before the current chunk.
+//
+// A chunk is only reclaimed by the garbage collector once none of its values are referenced anymore,
+// so long-lived containers that shrink considerably may hold on to more memory than the heap allocated nodes would.
+package arena
+
+// Arena holds the current chunk and the released values.
+type Arena[T any] struct {
+	chunkSize int
+	chunk     []T  // values of the current chunk that were not handed out yet
+	free      []*T // released values, handed out before the chunk
+}
+
+// New instantiates an arena allocating chunkSize values at a time.
+// A chunk size less than two only recycles released values.
+func New[T any](chunkSize int) *Arena[T] {
+	return &Arena[T]{chunkSize: max(chunkSize, 1)}
+}
+
+// Alloc returns a pointer to a zero value, or to a value passed to Reuse.
+func (arena *Arena[T]) Alloc() *T {
+	if n := len(arena.free); n > 0 {
+		value := arena.free[n-1]
+		arena.free[n-1] = nil
+		arena.free = arena.free[:n-1]
+		return value
+	}
+	if len(arena.chunk) == 0 {
+		arena.chunk = make([]T, arena.chunkSize)
+	}
+	value := &arena.chunk[0]
+	arena.chunk = arena.chunk[1:]
+	return value
+}
+
+// Free zeroes the value, so that it does not keep anything alive, and makes it available to Alloc.
+// The value must not be used by the caller anymore.
+func (arena *Arena[T]) Free(value *T) {
+	var zero T
+	*value = zero
+	arena.free = append(arena.free, value)
+}
+
+// Reuse makes the value available to Alloc as it is, so that e.g. the capacity of slices it holds can be reused.
+// The caller has to clear anything the value should not keep alive, and must not use the value anymore.
+func (arena *Arena[T]) Reuse(value *T) {
+	arena.free = append(arena.free, value)
+}
+
+// Reset drops the released values, so that the garbage collector can reclaim their chunks once the values handed out
+// before are not referenced anymore. Called when a container is cleared, the current chunk is kept for new values.
+func (arena *Arena[T]) Reset() {
+	arena.free = nil
+}
+
+// ChunkSize returns the number of values allocated at a time.
+func (arena *Arena[T]) ChunkSize() int {
+	return arena.chunkSize
+}
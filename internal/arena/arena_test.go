@@ -0,0 +1,82 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arena
+
+import (
+	"testing"
+)
+
+type node struct {
+	value int
+	next  *node
+}
+
+func TestArenaAlloc(t *testing.T) {
+	arena := New[node](3)
+	a, b, c, d := arena.Alloc(), arena.Alloc(), arena.Alloc(), arena.Alloc()
+	for _, n := range []*node{a, b, c, d} {
+		if actualValue, expectedValue := *n, (node{}); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := b, a; actualValue == expectedValue {
+		t.Errorf("Got %v expected a different value than %v", actualValue, expectedValue)
+	}
+	// a single allocation for the whole chunk
+	allocs := testing.AllocsPerRun(10, func() {
+		arena := New[node](100)
+		for i := 0; i < 100; i++ {
+			arena.Alloc()
+		}
+	})
+	if actualValue, expectedValue := allocs, 1.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := arena.ChunkSize(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := New[node](0).ChunkSize(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestArenaFree(t *testing.T) {
+	arena := New[node](2)
+	a, b := arena.Alloc(), arena.Alloc()
+	a.value, a.next = 1, b
+	b.value = 2
+	arena.Free(a)
+	arena.Free(b)
+	if actualValue, expectedValue := *a, (node{}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := arena.Alloc(), b; actualValue != expectedValue {
+		t.Errorf("Got %p expected %p", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := arena.Alloc(), a; actualValue != expectedValue {
+		t.Errorf("Got %p expected %p", actualValue, expectedValue)
+	}
+	a.next = b
+	arena.Reuse(a)
+	if actualValue, expectedValue := arena.Alloc(), a; actualValue != expectedValue || actualValue.next != b {
+		t.Errorf("Got %p expected %p", actualValue, expectedValue)
+	}
+	arena.Free(a)
+	arena.Reset()
+	if actualValue, expectedValue := arena.Alloc(), a; actualValue == expectedValue {
+		t.Errorf("Got %p expected a new value", actualValue)
+	}
+	if actualValue, expectedValue := len(arena.chunk), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func BenchmarkArenaAlloc(b *testing.B) {
+	b.ReportAllocs()
+	arena := New[node](1024)
+	for i := 0; i < b.N; i++ {
+		arena.Alloc()
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package dot writes directed graphs in the Graphviz DOT language, used by the trees to export their structure.
+//
+// Reference: https://graphviz.org/doc/info/lang.html
+package dot
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Graph holds the statements of a directed graph written so far.
+type Graph struct {
+	buf   strings.Builder
+	nodes int
+}
+
+// New instantiates a graph with the given name and default node attributes, e.g. "shape=circle".
+func New(name string, nodeAttributes string) *Graph {
+	graph := &Graph{}
+	graph.buf.WriteString("digraph " + strconv.Quote(name) + " {\n")
+	if nodeAttributes != "" {
+		graph.buf.WriteString("\tnode [" + nodeAttributes + "];\n")
+	}
+	return graph
+}
+
+// Node adds a node with the given label and attributes, e.g. "fillcolor=red", and returns its id.
+func (graph *Graph) Node(label string, attributes ...string) int {
+	id := graph.nodes
+	graph.nodes++
+	graph.buf.WriteString("\tn" + strconv.Itoa(id) + " [label=" + strconv.Quote(label))
+	for _, attribute := range attributes {
+		graph.buf.WriteString(", " + attribute)
+	}
+	graph.buf.WriteString("];\n")
+	return id
+}
+
+// Placeholder adds an invisible node and an invisible edge to it from the given node, and returns its id.
+// Used in place of missing children of binary trees, so that left and right children are laid out apart.
+func (graph *Graph) Placeholder(from int) int {
+	id := graph.Node("", "style=invis")
+	graph.Edge(from, id, "style=invis")
+	return id
+}
+
+// Edge adds an edge between the nodes with the given ids and attributes.
+func (graph *Graph) Edge(from, to int, attributes ...string) {
+	graph.buf.WriteString("\tn" + strconv.Itoa(from) + " -> n" + strconv.Itoa(to))
+	if len(attributes) > 0 {
+		graph.buf.WriteString(" [" + strings.Join(attributes, ", ") + "]")
+	}
+	graph.buf.WriteString(";\n")
+}
+
+// String returns the graph in the DOT language.
+func (graph *Graph) String() string {
+	return graph.buf.String() + "}\n"
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dot
+
+import (
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	graph := New("Tree", "shape=circle")
+	root := graph.Node("a \"quoted\" label", "fillcolor=red")
+	child := graph.Node("b")
+	graph.Edge(root, child, "label=L")
+	graph.Placeholder(root)
+	expected := `digraph "Tree" {
+	node [shape=circle];
+	n0 [label="a \"quoted\" label", fillcolor=red];
+	n1 [label="b"];
+	n0 -> n1 [label=L];
+	n2 [label="", style=invis];
+	n0 -> n2 [style=invis];
+}
+`
+	if actualValue, expectedValue := graph.String(), expected; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphEmpty(t *testing.T) {
+	if actualValue, expectedValue := New("Empty", "").String(), "digraph \"Empty\" {\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -11,6 +11,7 @@ package arraylist
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/ugurcsen/gods-generic/lists"
@@ -21,27 +22,72 @@ import (
 var _ lists.List[int] = (*List[int])(nil)
 
 // List holds the elements in a slice
-type List[T comparable] struct {
-	elements []T
-	size     int
+type List[T any] struct {
+	elements     []T
+	size         int
+	equality     utils.Equality[T]
+	growthFactor float32
+	shrinkFactor float32
+	version      int // incremented on every modification, used by iterators to detect concurrent modification
 }
 
 const (
-	growthFactor = float32(2.0)  // growth by 100%
-	shrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
+	defaultGrowthFactor = float32(2.0)  // growth by 100%
+	defaultShrinkFactor = float32(0.25) // shrink when size is 25% of capacity (0 means never shrink)
 )
 
 // New instantiates a new list and adds the passed values, if any, to the list
-func New[T comparable](values ...T) *List[T] {
-	list := &List[T]{}
+func New[T any](values ...T) *List[T] {
+	list := &List[T]{equality: utils.DefaultEquality[T](), growthFactor: defaultGrowthFactor, shrinkFactor: defaultShrinkFactor}
 	if len(values) > 0 {
 		list.Add(values...)
 	}
 	return list
 }
 
+// NewWithEquality instantiates a new list that compares values with the given equality
+// (used by Contains, IndexOf and Equal) and adds the passed values, if any, to the list
+func NewWithEquality[T any](equality utils.Equality[T], values ...T) *List[T] {
+	list := &List[T]{equality: equality, growthFactor: defaultGrowthFactor, shrinkFactor: defaultShrinkFactor}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// SetGrowthFactor sets the factor by which the capacity of the list grows when it is reached (2.0 by default).
+// A factor of 1 or less grows the capacity only by what is needed.
+func (list *List[T]) SetGrowthFactor(growthFactor float32) {
+	list.growthFactor = growthFactor
+}
+
+// SetShrinkFactor sets the ratio of size to capacity at which the capacity shrinks to the size after removals
+// (0.25 by default). A factor of 0 means never shrink.
+func (list *List[T]) SetShrinkFactor(shrinkFactor float32) {
+	list.shrinkFactor = shrinkFactor
+}
+
+// EnsureCapacity grows the capacity of the list, if necessary, so that it can hold at least the given number of
+// elements without growing again.
+func (list *List[T]) EnsureCapacity(capacity int) {
+	if cap(list.elements) < capacity {
+		list.resize(capacity)
+	}
+}
+
+// TrimToSize shrinks the capacity of the list to its size.
+func (list *List[T]) TrimToSize() {
+	if cap(list.elements) > list.size {
+		list.resize(list.size)
+	}
+}
+
 // Add appends a value at the end of the list
 func (list *List[T]) Add(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	list.growBy(len(values))
 	for _, value := range values {
 		list.elements[list.size] = value
@@ -67,6 +113,7 @@ func (list *List[T]) Remove(index int) {
 	if !list.withinRange(index) {
 		return
 	}
+	list.version++
 	var emptyT T
 	list.elements[index] = emptyT                                 // cleanup reference
 	copy(list.elements[index:], list.elements[index+1:list.size]) // shift to the left by one (slow operation, need ways to optimize this)
@@ -75,27 +122,108 @@ func (list *List[T]) Remove(index int) {
 	list.shrink()
 }
 
+// RemoveRange removes the elements from index from (inclusive) to index to (exclusive).
+// Does not do anything if the range is not within bounds of the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.size || from >= to {
+		return
+	}
+	list.version++
+	copy(list.elements[from:], list.elements[to:list.size])
+	list.clearTail(list.size - (to - from))
+	list.shrink()
+}
+
+// RemoveIf removes all elements satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RemoveIf(f func(value T) bool) int {
+	size := 0
+	for _, element := range list.elements[:list.size] {
+		if !f(element) {
+			list.elements[size] = element
+			size++
+		}
+	}
+	removed := list.size - size
+	if removed > 0 {
+		list.version++
+	}
+	list.clearTail(size)
+	list.shrink()
+	return removed
+}
+
+// RetainIf removes all elements not satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RetainIf(f func(value T) bool) int {
+	return list.RemoveIf(func(value T) bool { return !f(value) })
+}
+
+// AddAll appends all elements of the other list at the end of the list.
+func (list *List[T]) AddAll(other lists.List[T]) {
+	list.Add(other.Values()...)
+}
+
+// InsertAll inserts all elements of the other list at specified index position shifting the value at that position
+// (if any) and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) InsertAll(index int, other lists.List[T]) {
+	list.Insert(index, other.Values()...)
+}
+
+// SubList returns a new list holding the elements from index from (inclusive) to index to (exclusive).
+// Returns an empty list if the range is not within bounds of the list.
+func (list *List[T]) SubList(from, to int) *List[T] {
+	newList := &List[T]{equality: list.equality, growthFactor: list.growthFactor, shrinkFactor: list.shrinkFactor}
+	if from < 0 || to > list.size || from >= to {
+		return newList
+	}
+	newList.Add(list.elements[from:to]...)
+	return newList
+}
+
+// Reverse reverses the order of the elements (in-place).
+func (list *List[T]) Reverse() {
+	if list.size < 2 {
+		return
+	}
+	list.version++
+	slices.Reverse(list.elements[:list.size])
+}
+
+// Rotate rotates the elements by k positions to the right (in-place), i.e. the last k elements are moved to the
+// front of the list. Negative k rotates to the left.
+func (list *List[T]) Rotate(k int) {
+	if list.size < 2 {
+		return
+	}
+	k = (k%list.size + list.size) % list.size
+	if k == 0 {
+		return
+	}
+	list.version++
+	slices.Reverse(list.elements[:list.size])
+	slices.Reverse(list.elements[:k])
+	slices.Reverse(list.elements[k:list.size])
+}
+
 // Contains checks if elements (one or more) are present in the set.
 // All elements have to be present in the set for the method to return true.
 // Performance time complexity of n^2.
 // Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
 func (list *List[T]) Contains(values ...T) bool {
-
-	for _, searchValue := range values {
-		found := false
-		for index := 0; index < list.size; index++ {
-			if list.elements[index] == searchValue {
-				found = true
-				break
-			}
-		}
-		if !found {
+	for _, value := range values {
+		if list.IndexOf(value) < 0 {
 			return false
 		}
 	}
 	return true
 }
 
+// ContainsFunc checks if any element of the list satisfies the given function.
+func (list *List[T]) ContainsFunc(f func(value T) bool) bool {
+	return list.IndexOfFunc(f) >= 0
+}
+
 // Values returns all elements in the list.
 func (list *List[T]) Values() []T {
 	newElements := make([]T, list.size, list.size)
@@ -103,19 +231,38 @@ func (list *List[T]) Values() []T {
 	return newElements
 }
 
-// IndexOf returns index of provided element
+// IndexOf returns index of provided element, or -1 if the list does not contain it
 func (list *List[T]) IndexOf(value T) int {
-	if list.size == 0 {
-		return -1
+	return list.IndexOfFunc(func(element T) bool { return list.equal(element, value) })
+}
+
+// IndexOfFunc returns index of the first element satisfying the given function, or -1 if there is none
+func (list *List[T]) IndexOfFunc(f func(value T) bool) int {
+	for index, element := range list.elements[:list.size] {
+		if f(element) {
+			return index
+		}
 	}
-	for index, element := range list.elements {
-		if element == value {
+	return -1
+}
+
+// LastIndexOf returns index of the last occurrence of provided element, or -1 if the list does not contain it
+func (list *List[T]) LastIndexOf(value T) int {
+	for index := list.size - 1; index >= 0; index-- {
+		if list.equal(list.elements[index], value) {
 			return index
 		}
 	}
 	return -1
 }
 
+// BinarySearch searches for the value in the list sorted in ascending order with respect to the given comparator.
+// Returns the index of the first element equal to the value and true if found,
+// otherwise the index where the value would be inserted to keep the list sorted and false.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (int, bool) {
+	return utils.BinarySearch(list.elements[:list.size], value, comparator)
+}
+
 // Empty returns true if list does not contain any elements.
 func (list *List[T]) Empty() bool {
 	return list.size == 0
@@ -128,21 +275,33 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.version++
 	list.size = 0
 	list.elements = []T{}
 }
 
 // Sort sorts values (in-place) using.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
-	if len(list.elements) < 2 {
+	if list.size < 2 {
 		return
 	}
+	list.version++
 	utils.Sort(list.elements[:list.size], comparator)
 }
 
+// SortStable sorts values (in-place) keeping the order of equal values.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
+	if list.size < 2 {
+		return
+	}
+	list.version++
+	utils.SortStable(list.elements[:list.size], comparator)
+}
+
 // Swap swaps the two values at the specified positions.
 func (list *List[T]) Swap(i, j int) {
-	if list.withinRange(i) && list.withinRange(j) {
+	if list.withinRange(i) && list.withinRange(j) && i != j {
+		list.version++
 		list.elements[i], list.elements[j] = list.elements[j], list.elements[i]
 	}
 }
@@ -161,7 +320,12 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 
 	l := len(values)
+	if l == 0 {
+		return
+	}
+	list.version++
 	list.growBy(l)
+
 	list.size += l
 	copy(list.elements[index+l:], list.elements[index:list.size-l])
 	copy(list.elements[index:], values)
@@ -210,19 +374,33 @@ func (list *List[T]) growBy(n int) {
 	// When capacity is reached, grow by a factor of growthFactor and add number of elements
 	currentCapacity := cap(list.elements)
 	if list.size+n >= currentCapacity {
-		newCapacity := int(growthFactor * float32(currentCapacity+n))
-		list.resize(newCapacity)
+		newCapacity := int(list.growthFactor * float32(currentCapacity+n))
+		list.resize(max(newCapacity, list.size+n))
 	}
 }
 
 // Shrink the array if necessary, i.e. when size is shrinkFactor percent of current capacity
 func (list *List[T]) shrink() {
-	if shrinkFactor == 0.0 {
+	if list.shrinkFactor == 0.0 {
 		return
 	}
 	// Shrink when size is at shrinkFactor * capacity
 	currentCapacity := cap(list.elements)
-	if list.size <= int(float32(currentCapacity)*shrinkFactor) {
+	if list.size <= int(float32(currentCapacity)*list.shrinkFactor) {
 		list.resize(list.size)
 	}
 }
+
+// Zero the elements from the given size on (cleanup references) and truncate the list to that size
+func (list *List[T]) clearTail(size int) {
+	clear(list.elements[size:list.size])
+	list.size = size
+}
+
+// Compare two values with the list's equality, utils.DefaultEquality is used if none was given
+func (list *List[T]) equal(a, b T) bool {
+	if list.equality == nil {
+		return utils.DefaultEquality[T]()(a, b)
+	}
+	return list.equality(a, b)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arraylist
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*List[int], int] = (*List[int])(nil)
+
+// Clone returns a copy of the list holding the same elements in the same order.
+func (list *List[T]) Clone() *List[T] {
+	elements := make([]T, len(list.elements), cap(list.elements))
+	copy(elements, list.elements[:list.size])
+	return &List[T]{elements: elements, size: list.size, equality: list.equality, growthFactor: list.growthFactor, shrinkFactor: list.shrinkFactor}
+}
+
+// DeepClone returns a copy of the list where every element is passed through the given copy function.
+func (list *List[T]) DeepClone(copyValue func(value T) T) *List[T] {
+	elements := make([]T, len(list.elements), cap(list.elements))
+	for index, element := range list.elements[:list.size] {
+		elements[index] = copyValue(element)
+	}
+	return &List[T]{elements: elements, size: list.size, equality: list.equality, growthFactor: list.growthFactor, shrinkFactor: list.shrinkFactor}
+}
+
+// Equal returns true if both lists hold equal elements in the same order.
+func (list *List[T]) Equal(other *List[T]) bool {
+	if list.size != other.size {
+		return false
+	}
+	for index, element := range list.elements[:list.size] {
+		if !list.equal(element, other.elements[index]) {
+			return false
+		}
+	}
+	return true
+}
@@ -10,14 +10,17 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator holding the iterator's state
-type Iterator[T comparable] struct {
-	list  *List[T]
-	index int
+type Iterator[T any] struct {
+	list    *List[T]
+	index   int
+	version int
+	removed bool // current element was removed through the iterator
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the list is modified during iteration.
 func (list *List[T]) Iterator() Iterator[T] {
-	return Iterator[T]{list: list, index: -1}
+	return Iterator[T]{list: list, index: -1, version: list.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +28,16 @@ func (list *List[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.list.version
+	}
+	iterator.checkVersion()
+	if iterator.removed {
+		// the element that followed the removed one has moved to its index
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
+
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -35,6 +48,8 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
+	iterator.removed = false
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -44,6 +59,7 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
 	return iterator.list.elements[iterator.index]
 }
 
@@ -57,12 +73,16 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.version = iterator.list.version
+	iterator.removed = false
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.list.size
+	iterator.version = iterator.list.version
+	iterator.removed = false
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -108,3 +128,37 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the list and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Value() is undefined until then.
+// Removing through the iterator is the only modification of the list that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.list.Remove(iterator.index)
+	iterator.version = iterator.list.version
+	iterator.removed = true
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.list.elements[iterator.index] = value
+	return true
+}
+
+// checkVersion panics if the list was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.list.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -22,7 +22,9 @@ func (list *List[T]) ToJSON() ([]byte, error) {
 func (list *List[T]) FromJSON(data []byte) error {
 	err := json.Unmarshal(data, &list.elements)
 	if err == nil {
+		list.version++
 		list.size = len(list.elements)
+
 	}
 	return err
 }
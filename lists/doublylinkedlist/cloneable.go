@@ -0,0 +1,43 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doublylinkedlist
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/internal/arena"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*List[int], int] = (*List[int])(nil)
+
+// Clone returns a copy of the list holding the same elements in the same order.
+func (list *List[T]) Clone() *List[T] {
+	return list.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the list where every element is passed through the given copy function.
+func (list *List[T]) DeepClone(copyValue func(value T) T) *List[T] {
+	newList := &List[T]{equality: list.equality}
+	if list.elements != nil {
+		newList.elements = arena.New[Element[T]](list.elements.ChunkSize())
+	}
+	for element := list.first; element != nil; element = element.next {
+		newList.Add(copyValue(element.Value))
+	}
+	return newList
+}
+
+// Equal returns true if both lists hold equal elements in the same order.
+func (list *List[T]) Equal(other *List[T]) bool {
+	if list.size != other.size {
+		return false
+	}
+	for element1, element2 := list.first, other.first; element1 != nil; element1, element2 = element1.next, element2.next {
+		if !list.equal(element1.Value, element2.Value) {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,9 @@
 
 // Package doublylinkedlist implements the doubly-linked list.
 //
+// Besides access by index, elements can be accessed through handles (see Element), which allow inserting, removing
+// and moving elements in constant time.
+//
 // Structure is not thread safe.
 //
 // Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
@@ -13,6 +16,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ugurcsen/gods-generic/internal/arena"
 	"github.com/ugurcsen/gods-generic/lists"
 	"github.com/ugurcsen/gods-generic/utils"
 )
@@ -21,21 +25,69 @@ import (
 var _ lists.List[int] = (*List[int])(nil)
 
 // List holds the elements, where each element points to the next and previous element
-type List[T comparable] struct {
-	first *element[T]
-	last  *element[T]
-	size  int
+type List[T any] struct {
+	first    *Element[T]
+	last     *Element[T]
+	size     int
+	equality utils.Equality[T]
+	owner    *owner[T]
+	version  int                      // incremented on every modification, used by iterators to detect concurrent modification
+	elements *arena.Arena[Element[T]] // allocates the elements if set, otherwise they are allocated one by one
+	handles  bool                     // set once a handle was handed out, removed elements are not reused afterwards
+}
+
+// Element is a handle to an element of the list, returned by PushBack, PushFront, InsertBefore, InsertAfter,
+// Front and Back. The handle stays valid until the element is removed from the list.
+type Element[T any] struct {
+	Value T
+	prev  *Element[T]
+	next  *Element[T]
+	owner *owner[T]
+}
+
+// owner tracks the list that elements belong to. Splicing a list into another one links the owner of the spliced
+// elements to the owner of the receiving list (union-find), so that the elements do not have to be updated one by one.
+type owner[T any] struct {
+	list   *List[T]
+	parent *owner[T]
+}
+
+// Next returns the next element of the list or nil if there is none.
+func (element *Element[T]) Next() *Element[T] {
+	return element.next
 }
 
-type element[T comparable] struct {
-	value T
-	prev  *element[T]
-	next  *element[T]
+// Prev returns the previous element of the list or nil if there is none.
+func (element *Element[T]) Prev() *Element[T] {
+	return element.prev
 }
 
 // New instantiates a new list and adds the passed values, if any, to the list
-func New[T comparable](values ...T) *List[T] {
-	list := &List[T]{}
+func New[T any](values ...T) *List[T] {
+	list := &List[T]{equality: utils.DefaultEquality[T]()}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// NewWithArena instantiates a new list whose elements are allocated chunkSize at a time and reused after removal,
+// and adds the passed values, if any, to the list. This reduces the number of heap objects, and thus the garbage
+// collection work, of large lists. A chunk is only reclaimed once all of its elements are removed.
+// Removed elements are only reused until the list hands out a handle (see Element), so that a handle of a removed
+// element never refers to an element holding another value. A chunk size of one only reuses removed elements.
+func NewWithArena[T any](chunkSize int, values ...T) *List[T] {
+	list := &List[T]{elements: arena.New[Element[T]](chunkSize), equality: utils.DefaultEquality[T]()}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// NewWithEquality instantiates a new list that compares values with the given equality
+// (used by Contains, IndexOf and Equal) and adds the passed values, if any, to the list
+func NewWithEquality[T any](equality utils.Equality[T], values ...T) *List[T] {
+	list := &List[T]{equality: equality}
 	if len(values) > 0 {
 		list.Add(values...)
 	}
@@ -44,8 +96,13 @@ func New[T comparable](values ...T) *List[T] {
 
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List[T]) Add(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	for _, value := range values {
-		newElement := &element[T]{value: value, prev: list.last}
+		newElement := list.newElement(value)
+		newElement.prev = list.last
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -65,8 +122,13 @@ func (list *List[T]) Append(values ...T) {
 // Prepend prepends a values (or more)
 func (list *List[T]) Prepend(values ...T) {
 	// in reverse to keep passed order i.e. ["c","d"] -> Prepend(["a","b"]) -> ["a","b","c",d"]
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	for v := len(values) - 1; v >= 0; v-- {
-		newElement := &element[T]{value: values[v], next: list.first}
+		newElement := list.newElement(values[v])
+		newElement.next = list.first
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -78,6 +140,141 @@ func (list *List[T]) Prepend(values ...T) {
 	}
 }
 
+// AddAll appends all elements of the other list at the end of the list.
+func (list *List[T]) AddAll(other lists.List[T]) {
+	list.Add(other.Values()...)
+}
+
+// PushFront prepends the value and returns the handle of its element.
+func (list *List[T]) PushFront(value T) *Element[T] {
+	element := list.newElement(value)
+	list.linkAfter(element, nil)
+	return list.handle(element)
+}
+
+// PushBack appends the value and returns the handle of its element.
+func (list *List[T]) PushBack(value T) *Element[T] {
+	element := list.newElement(value)
+	list.linkAfter(element, list.last)
+	return list.handle(element)
+}
+
+// InsertBefore inserts the value right before the mark element and returns the handle of its element.
+// Does not do anything and returns nil if mark is not an element of the list.
+func (list *List[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if !list.owns(mark) {
+		return nil
+	}
+	element := list.newElement(value)
+	list.linkAfter(element, mark.prev)
+	return list.handle(element)
+}
+
+// InsertAfter inserts the value right after the mark element and returns the handle of its element.
+// Does not do anything and returns nil if mark is not an element of the list.
+func (list *List[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if !list.owns(mark) {
+		return nil
+	}
+	element := list.newElement(value)
+	list.linkAfter(element, mark)
+	return list.handle(element)
+}
+
+// RemoveElement removes the element from the list and returns its value.
+// Second return parameter is true, unless the element is not an element of the list and there was nothing to remove.
+func (list *List[T]) RemoveElement(element *Element[T]) (value T, ok bool) {
+	if !list.owns(element) {
+		return value, false
+	}
+	value = element.Value
+	list.unlink(element)
+	list.freeElement(element)
+	return value, true
+}
+
+// MoveToFront moves the element to the front of the list.
+// Does not do anything if element is not an element of the list.
+func (list *List[T]) MoveToFront(element *Element[T]) {
+	if !list.owns(element) || element == list.first {
+		return
+	}
+	list.unlink(element)
+	list.linkAfter(element, nil)
+}
+
+// MoveToBack moves the element to the back of the list.
+// Does not do anything if element is not an element of the list.
+func (list *List[T]) MoveToBack(element *Element[T]) {
+	if !list.owns(element) || element == list.last {
+		return
+	}
+	list.unlink(element)
+	list.linkAfter(element, list.last)
+}
+
+// MoveBefore moves the element right before the mark element.
+// Does not do anything if element or mark is not an element of the list, or if they are the same element.
+func (list *List[T]) MoveBefore(element, mark *Element[T]) {
+	if element == mark || !list.owns(element) || !list.owns(mark) {
+		return
+	}
+	list.unlink(element)
+	list.linkAfter(element, mark.prev)
+}
+
+// MoveAfter moves the element right after the mark element.
+// Does not do anything if element or mark is not an element of the list, or if they are the same element.
+func (list *List[T]) MoveAfter(element, mark *Element[T]) {
+	if element == mark || !list.owns(element) || !list.owns(mark) {
+		return
+	}
+	list.unlink(element)
+	list.linkAfter(element, mark)
+}
+
+// Splice moves all elements of the other list right before the at element, or to the back of the list if at is nil.
+// The other list is left empty and handles of its elements become handles of elements of this list.
+// Does not do anything if at is not nil and not an element of the list, or if other is the list itself.
+func (list *List[T]) Splice(other *List[T], at *Element[T]) {
+	if other == list || other.size == 0 || (at != nil && !list.owns(at)) {
+		return
+	}
+	before := list.last
+	if at != nil {
+		before = at.prev
+	}
+	other.first.prev = before
+	if before == nil {
+		list.first = other.first
+	} else {
+		before.next = other.first
+	}
+	other.last.next = at
+	if at == nil {
+		list.last = other.last
+	} else {
+		at.prev = other.last
+	}
+	list.size += other.size
+	list.version++
+	list.handles = list.handles || other.handles
+	other.version++
+	other.owner.list = nil
+	other.owner.parent = list.ownerOf()
+	other.first, other.last, other.size, other.owner, other.handles = nil, nil, 0, nil, false
+}
+
+// Front returns the handle of the first element of the list or nil if the list is empty.
+func (list *List[T]) Front() *Element[T] {
+	return list.handle(list.first)
+}
+
+// Back returns the handle of the last element of the list or nil if the list is empty.
+func (list *List[T]) Back() *Element[T] {
+	return list.handle(list.last)
+}
+
 // Get returns the element at index.
 // Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
 func (list *List[T]) Get(index int) (T, bool) {
@@ -92,12 +289,12 @@ func (list *List[T]) Get(index int) (T, bool) {
 		element := list.last
 		for e := list.size - 1; e != index; e, element = e-1, element.prev {
 		}
-		return element.value, true
+		return element.Value, true
 	}
 	element := list.first
 	for e := 0; e != index; e, element = e+1, element.next {
 	}
-	return element.value, true
+	return element.Value, true
 }
 
 // Remove removes the element at the given index from the list.
@@ -106,13 +303,9 @@ func (list *List[T]) Remove(index int) {
 	if !list.withinRange(index) {
 		return
 	}
+	list.version++
 
-	if list.size == 1 {
-		list.Clear()
-		return
-	}
-
-	var element *element[T]
+	var element *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		element = list.last
@@ -137,60 +330,143 @@ func (list *List[T]) Remove(index int) {
 		element.next.prev = element.prev
 	}
 
-	element = nil
+	list.freeElement(element)
 
 	list.size--
 }
 
+// RemoveRange removes the elements from index from (inclusive) to index to (exclusive).
+// Does not do anything if the range is not within bounds of the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.size || from >= to {
+		return
+	}
+	list.version++
+	element := list.first
+	for e := 0; e != from; e, element = e+1, element.next {
+	}
+	beforeElement := element.prev
+	for e := from; e != to; e++ {
+		next := element.next
+		list.freeElement(element)
+		element = next
+	}
+	// element is now the first element after the range, if any
+	if beforeElement == nil {
+		list.first = element
+	} else {
+		beforeElement.next = element
+	}
+	if element == nil {
+		list.last = beforeElement
+	} else {
+		element.prev = beforeElement
+	}
+	list.size -= to - from
+}
+
+// RemoveIf removes all elements satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RemoveIf(f func(value T) bool) int {
+	removed := 0
+	var beforeElement, next *Element[T]
+	for element := list.first; element != nil; element = next {
+		next = element.next
+		if f(element.Value) {
+			list.freeElement(element)
+			removed++
+			continue
+		}
+		element.prev = beforeElement
+		if beforeElement == nil {
+			list.first = element
+		} else {
+			beforeElement.next = element
+		}
+		beforeElement = element
+	}
+	if beforeElement == nil {
+		list.first = nil
+	} else {
+		beforeElement.next = nil
+	}
+	list.last = beforeElement
+	list.size -= removed
+	if removed > 0 {
+		list.version++
+	}
+	return removed
+}
+
+// RetainIf removes all elements not satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RetainIf(f func(value T) bool) int {
+	return list.RemoveIf(func(value T) bool { return !f(value) })
+}
+
 // Contains check if values (one or more) are present in the set.
 // All values have to be present in the set for the method to return true.
 // Performance time complexity of n^2.
 // Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
 func (list *List[T]) Contains(values ...T) bool {
-
-	if len(values) == 0 {
-		return true
-	}
-	if list.size == 0 {
-		return false
-	}
 	for _, value := range values {
-		found := false
-		for element := list.first; element != nil; element = element.next {
-			if element.value == value {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if list.IndexOf(value) < 0 {
 			return false
 		}
 	}
 	return true
 }
 
+// ContainsFunc checks if any element of the list satisfies the given function.
+func (list *List[T]) ContainsFunc(f func(value T) bool) bool {
+	return list.IndexOfFunc(f) >= 0
+}
+
 // Values returns all elements in the list.
 func (list *List[T]) Values() []T {
 	values := make([]T, list.size, list.size)
 	for e, element := 0, list.first; element != nil; e, element = e+1, element.next {
-		values[e] = element.value
+		values[e] = element.Value
 	}
 	return values
 }
 
-// IndexOf returns index of provided element
+// IndexOf returns index of provided element, or -1 if the list does not contain it
 func (list *List[T]) IndexOf(value T) int {
-	if list.size == 0 {
-		return -1
+	return list.IndexOfFunc(func(element T) bool { return list.equal(element, value) })
+}
+
+// IndexOfFunc returns index of the first element satisfying the given function, or -1 if there is none
+func (list *List[T]) IndexOfFunc(f func(value T) bool) int {
+	for index, element := 0, list.first; element != nil; index, element = index+1, element.next {
+		if f(element.Value) {
+			return index
+		}
 	}
-	for index, element := range list.Values() {
-		if element == value {
+	return -1
+}
+
+// LastIndexOf returns index of the last occurrence of provided element, or -1 if the list does not contain it
+func (list *List[T]) LastIndexOf(value T) int {
+	for index, element := list.size-1, list.last; element != nil; index, element = index-1, element.prev {
+		if list.equal(element.Value, value) {
 			return index
 		}
 	}
 	return -1
 }
 
+// BinarySearch searches for the value in the list sorted in ascending order with respect to the given comparator.
+// Returns the index of the first element equal to the value and true if found,
+// otherwise the index where the value would be inserted to keep the list sorted and false.
+// Elements cannot be accessed at random, so the list is scanned up to that index.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (int, bool) {
+	for index, element := 0, list.first; element != nil; index, element = index+1, element.next {
+		if result := comparator(element.Value, value); result >= 0 {
+			return index, result == 0
+		}
+	}
+	return list.size, false
+}
+
 // Empty returns true if list does not contain any elements.
 func (list *List[T]) Empty() bool {
 	return list.size == 0
@@ -203,31 +479,46 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.version++
 	list.size = 0
 	list.first = nil
 	list.last = nil
+	if list.owner != nil {
+		// detach handles of the removed elements from the list
+		list.owner.list = nil
+		list.owner = nil
+	}
+	if list.elements != nil {
+		// the handed out handles refer to none of the elements left to reuse
+		list.elements.Reset()
+		list.handles = false
+	}
 }
 
-// Sort sorts values (in-place) using.
+// Sort sorts values (in-place) using merge sort, relinking the elements instead of copying values.
+// Merge sort is stable, i.e. equal values keep their order.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
-
 	if list.size < 2 {
 		return
 	}
+	list.version++
+	list.first = mergeSort(list.first, list.size, comparator)
+	list.first.prev = nil
+	for list.last = list.first; list.last.next != nil; list.last = list.last.next {
+		list.last.next.prev = list.last
+	}
+}
 
-	values := list.Values()
-	utils.Sort(values, comparator)
-
-	list.Clear()
-
-	list.Add(values...)
-
+// SortStable sorts values (in-place) keeping the order of equal values, same as Sort.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
+	list.Sort(comparator)
 }
 
 // Swap swaps values of two elements at the given indices.
 func (list *List[T]) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) && i != j {
-		var element1, element2 *element[T]
+		list.version++
+		var element1, element2 *Element[T]
 		for e, currentElement := 0, list.first; element1 == nil || element2 == nil; e, currentElement = e+1, currentElement.next {
 			switch e {
 			case i:
@@ -236,8 +527,66 @@ func (list *List[T]) Swap(i, j int) {
 				element2 = currentElement
 			}
 		}
-		element1.value, element2.value = element2.value, element1.value
+		element1.Value, element2.Value = element2.Value, element1.Value
+	}
+}
+
+// SubList returns a new list holding the elements from index from (inclusive) to index to (exclusive).
+// Returns an empty list if the range is not within bounds of the list.
+func (list *List[T]) SubList(from, to int) *List[T] {
+	newList := &List[T]{equality: list.equality}
+	if from < 0 || to > list.size || from >= to {
+		return newList
+	}
+	element := list.first
+	for e := 0; e != from; e, element = e+1, element.next {
+	}
+	for e := from; e != to; e, element = e+1, element.next {
+		newList.Add(element.Value)
+	}
+	return newList
+}
+
+// Reverse reverses the order of the elements (in-place), relinking the elements instead of copying values.
+func (list *List[T]) Reverse() {
+	if list.size < 2 {
+		return
+	}
+	list.version++
+	for element := list.first; element != nil; element = element.prev {
+		element.prev, element.next = element.next, element.prev
+	}
+	list.first, list.last = list.last, list.first
+}
+
+// Rotate rotates the elements by k positions to the right (in-place), i.e. the last k elements are moved to the
+// front of the list. Negative k rotates to the left.
+func (list *List[T]) Rotate(k int) {
+	if list.size < 2 {
+		return
+	}
+	k = (k%list.size + list.size) % list.size
+	if k == 0 {
+		return
+	}
+	list.version++
+	var newFirst *Element[T]
+	// determine traversal direction, last to first or first to last
+	if k < list.size-k {
+		newFirst = list.last
+		for e := 1; e != k; e, newFirst = e+1, newFirst.prev {
+		}
+	} else {
+		newFirst = list.first
+		for e := 0; e != list.size-k; e, newFirst = e+1, newFirst.next {
+		}
 	}
+	list.last.next = list.first
+	list.first.prev = list.last
+	list.first = newFirst
+	list.last = newFirst.prev
+	list.first.prev = nil
+	list.last.next = nil
 }
 
 // Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
@@ -253,27 +602,29 @@ func (list *List[T]) Insert(index int, values ...T) {
 		return
 	}
 
-	list.size += len(values)
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 
-	var beforeElement *element[T]
-	var foundElement *element[T]
+	var foundElement *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		foundElement = list.last
 		for e := list.size - 1; e != index; e, foundElement = e-1, foundElement.prev {
-			beforeElement = foundElement.prev
 		}
 	} else {
 		foundElement = list.first
 		for e := 0; e != index; e, foundElement = e+1, foundElement.next {
-			beforeElement = foundElement
 		}
 	}
+	beforeElement := foundElement.prev
+	list.size += len(values)
 
 	if foundElement == list.first {
 		oldNextElement := list.first
 		for i, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := list.newElement(value)
 			if i == 0 {
 				list.first = newElement
 			} else {
@@ -287,7 +638,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	} else {
 		oldNextElement := beforeElement.next
 		for _, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := list.newElement(value)
 			newElement.prev = beforeElement
 			beforeElement.next = newElement
 			beforeElement = newElement
@@ -297,6 +648,14 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 }
 
+// InsertAll inserts all elements of the other list at specified index position shifting the value at that position
+// (if any) and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) InsertAll(index int, other lists.List[T]) {
+	list.Insert(index, other.Values()...)
+}
+
 // Set value at specified index position
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
@@ -310,22 +669,19 @@ func (list *List[T]) Set(index int, value T) {
 		return
 	}
 
-	var foundElement *element[T]
+	var foundElement *Element[T]
 	// determine traversal direction, last to first or first to last
 	if list.size-index < index {
 		foundElement = list.last
-		for e := list.size - 1; e != index; {
-			fmt.Println("Set last", index, value, foundElement, foundElement.prev)
-			e, foundElement = e-1, foundElement.prev
+		for e := list.size - 1; e != index; e, foundElement = e-1, foundElement.prev {
 		}
 	} else {
 		foundElement = list.first
-		for e := 0; e != index; {
-			e, foundElement = e+1, foundElement.next
+		for e := 0; e != index; e, foundElement = e+1, foundElement.next {
 		}
 	}
 
-	foundElement.value = value
+	foundElement.Value = value
 }
 
 // String returns a string representation of container
@@ -333,7 +689,7 @@ func (list *List[T]) String() string {
 	str := "DoublyLinkedList\n"
 	values := []string{}
 	for element := list.first; element != nil; element = element.next {
-		values = append(values, fmt.Sprintf("%v", element.value))
+		values = append(values, fmt.Sprintf("%v", element.Value))
 	}
 	str += strings.Join(values, ", ")
 	return str
@@ -343,3 +699,137 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// Returns the owner of the list's elements, creating it if the list does not have one yet
+func (list *List[T]) ownerOf() *owner[T] {
+	if list.owner == nil {
+		list.owner = &owner[T]{list: list}
+	}
+	return list.owner
+}
+
+// Creates an unlinked element of the list holding the value, from the arena if the list has one
+func (list *List[T]) newElement(value T) *Element[T] {
+	if list.elements == nil {
+		return &Element[T]{Value: value, owner: list.ownerOf()}
+	}
+	element := list.elements.Alloc()
+	element.Value, element.owner = value, list.ownerOf()
+	return element
+}
+
+// Detaches the removed element from its neighbours and the list, and returns it to the arena if the list has one
+// and the element cannot be referred to by a handle
+func (list *List[T]) freeElement(element *Element[T]) {
+	if list.elements == nil || list.handles {
+		element.prev, element.next, element.owner = nil, nil, nil
+		return
+	}
+	list.elements.Free(element)
+}
+
+// Records that the element is handed out as a handle and returns it
+func (list *List[T]) handle(element *Element[T]) *Element[T] {
+	if element != nil {
+		list.handles = true
+	}
+	return element
+}
+
+// Check that the element belongs to the list, compressing the path to the owner of the list along the way
+func (list *List[T]) owns(element *Element[T]) bool {
+	if element == nil || element.owner == nil {
+		return false
+	}
+	root := element.owner
+	for root.parent != nil {
+		root = root.parent
+	}
+	for current := element.owner; current != root; {
+		next := current.parent
+		current.parent = root
+		current = next
+	}
+	element.owner = root
+	return root.list == list
+}
+
+// Links the element right after the mark element, or at the front of the list if mark is nil
+func (list *List[T]) linkAfter(element, mark *Element[T]) {
+	element.prev = mark
+	if mark == nil {
+		element.next = list.first
+		list.first = element
+	} else {
+		element.next = mark.next
+		mark.next = element
+	}
+	if element.next == nil {
+		list.last = element
+	} else {
+		element.next.prev = element
+	}
+	list.size++
+	list.version++
+}
+
+// Unlinks the element from its neighbours, keeping it owned by the list
+func (list *List[T]) unlink(element *Element[T]) {
+	if element.prev == nil {
+		list.first = element.next
+	} else {
+		element.prev.next = element.next
+	}
+	if element.next == nil {
+		list.last = element.prev
+	} else {
+		element.next.prev = element.prev
+	}
+	element.prev, element.next = nil, nil
+	list.size--
+	list.version++
+
+}
+
+// Compare two values with the list's equality, utils.DefaultEquality is used if none was given
+func (list *List[T]) equal(a, b T) bool {
+	if list.equality == nil {
+		return utils.DefaultEquality[T]()(a, b)
+	}
+	return list.equality(a, b)
+}
+
+// Sorts the chain of size elements starting at first and returns the new first element.
+// Only the next references are relinked.
+func mergeSort[T any](first *Element[T], size int, comparator utils.Comparator[T]) *Element[T] {
+	if size < 2 {
+		return first
+	}
+	middle := first
+	for i := 1; i < size/2; i++ {
+		middle = middle.next
+	}
+	second := middle.next
+	middle.next = nil
+	return merge(mergeSort(first, size/2, comparator), mergeSort(second, size-size/2, comparator), comparator)
+}
+
+// Merges two sorted chains, taking from the first chain on ties
+func merge[T any](first, second *Element[T], comparator utils.Comparator[T]) *Element[T] {
+	head := &Element[T]{}
+	tail := head
+	for first != nil && second != nil {
+		if comparator(second.Value, first.Value) < 0 {
+			tail.next, second = second, second.next
+		} else {
+			tail.next, first = first, first.next
+		}
+		tail = tail.next
+	}
+	if first != nil {
+		tail.next = first
+	} else {
+		tail.next = second
+	}
+	return head.next
+}
@@ -7,9 +7,13 @@ package doublylinkedlist
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/lists"
 	"github.com/ugurcsen/gods-generic/utils"
 )
 
@@ -231,6 +235,32 @@ func TestListInsert(t *testing.T) {
 	}
 }
 
+func TestListInsertBackHalf(t *testing.T) {
+	// indexes in the back half of the list are found by last to first traversal
+	tests := [][]interface{}{
+		{[]int{5, 11}, 1, []int{7, 8}, "[5 7 8 11]"},
+		{[]int{1, 2, 3, 4, 5}, 4, []int{7, 8}, "[1 2 3 4 7 8 5]"},
+		{[]int{1, 2, 3, 4, 5}, 3, []int{7, 8, 9, 10}, "[1 2 3 7 8 9 10 4 5]"},
+		{[]int{1, 2, 3, 4, 5}, 2, []int{7}, "[1 2 7 3 4 5]"},
+		{[]int{7, 8, 7, 8, 7, 8, 10, 5, 11}, 7, []int{7, 8}, "[7 8 7 8 7 8 10 7 8 5 11]"},
+	}
+	for _, test := range tests {
+		list := New[int](test[0].([]int)...)
+		list.Insert(test[1].(int), test[2].([]int)...)
+		if actualValue, expectedValue := fmt.Sprint(list.Values()), test[3].(string); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		list = New[int](test[0].([]int)...)
+		list.InsertAll(test[1].(int), New[int](test[2].([]int)...))
+		if actualValue, expectedValue := fmt.Sprint(list.Values()), test[3].(string); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.Back().Prev().Next(), list.Back(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestListSet(t *testing.T) {
 	list := New[string]()
 	list.Set(0, "a")
@@ -679,6 +709,684 @@ func TestListString(t *testing.T) {
 	}
 }
 
+func TestListClone(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	clone := list.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(clone.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Set(0, "x")
+	clone.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := list.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(deepClone.Values())...), "ABC"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	empty := New[string]().Clone()
+	if actualValue := empty.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	if actualValue := list.Equal(list.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "b", "c")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "c", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[string]().Equal(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListIndexOfFunc(t *testing.T) {
+	list := New[int](1, 2, 3, 4)
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value%2 == 0 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value > 4 }), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 4 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNonComparable(t *testing.T) {
+	list := New[[]int]([]int{1, 2}, []int{3})
+	list.Add(nil)
+	if actualValue, expectedValue := list.IndexOf([]int{3}), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1, 2}, nil), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(list.Clone()), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNewWithEquality(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	list := NewWithEquality(sameLength, "a", "bb", "ccc")
+	if actualValue, expectedValue := list.IndexOf("xx"), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains("x", "yyy"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := list.Select(func(index int, value string) bool { return index > 0 })
+	if actualValue, expectedValue := selected.Contains("zz"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(NewWithEquality(sameLength, "x", "yy", "zzz")), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSortStable(t *testing.T) {
+	list := New[string]("b2", "a1", "b1", "c1", "a2", "a3")
+	list.SortStable(func(a, b string) int { return utils.ByteComparator(a[0], b[0]) })
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d1")
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1 d1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSortRelinks(t *testing.T) {
+	list := New[int]()
+	for i := 0; i < 1000; i++ {
+		list.Add((i * 7919) % 1000)
+	}
+	list.Sort(utils.NumberComparator[int])
+	for i := 0; i < list.Size(); i++ {
+		if actualValue, _ := list.Get(i); actualValue != i {
+			t.Errorf("Got %v expected %v", actualValue, i)
+			break
+		}
+	}
+	expectedValue := 999
+	it := list.Iterator()
+	for it.End(); it.Prev(); expectedValue-- {
+		if actualValue := it.Value(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			break
+		}
+	}
+	if expectedValue != -1 {
+		t.Errorf("Got %v expected %v", expectedValue, -1)
+	}
+}
+
+func assertListValues(t *testing.T, list *List[int], expected string) {
+	t.Helper()
+	if actualValue := fmt.Sprintf("%v", list.Values()); actualValue != expected {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue, expectedValue := list.Size(), len(strings.Fields(strings.Trim(expected, "[]"))); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if list.size == 0 {
+		if list.first != nil || list.last != nil {
+			t.Errorf("Got %v %v expected %v %v", list.first, list.last, nil, nil)
+		}
+		return
+	}
+	if list.last.next != nil {
+		t.Errorf("Got %v expected %v", list.last.next, nil)
+	}
+	last := list.first
+	for last.next != nil {
+		last = last.next
+	}
+	if last != list.last {
+		t.Errorf("Got %v expected %v", list.last.Value, last.Value)
+	}
+	if list.first.prev != nil {
+		t.Errorf("Got %v expected %v", list.first.prev, nil)
+	}
+	for element := list.first; element.next != nil; element = element.next {
+		if element.next.prev != element {
+			t.Errorf("Got %v expected %v", element.next.prev.Value, element.Value)
+		}
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6)
+	list.RemoveRange(-1, 2)
+	list.RemoveRange(2, 8)
+	list.RemoveRange(3, 3)
+	assertListValues(t, list, "[0 1 2 3 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[0 1 4 5 6]")
+	list.RemoveRange(0, 1)
+	assertListValues(t, list, "[1 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[1 4]")
+	list.RemoveRange(0, 2)
+	assertListValues(t, list, "[]")
+	list.Add(7, 8)
+	assertListValues(t, list, "[7 8]")
+}
+
+func TestListRemoveIf(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	isEven := func(value int) bool { return value%2 == 0 }
+	if actualValue, expectedValue := list.RemoveIf(isEven), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[1 3 5 7]")
+	if actualValue, expectedValue := list.RetainIf(func(value int) bool { return value > 2 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[3 5 7]")
+	if actualValue, expectedValue := list.RemoveIf(isEven), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RetainIf(isEven), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	assertListValues(t, list, "[1]")
+}
+
+func TestListAddAllInsertAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	assertListValues(t, list, "[1 2 3 4]")
+	list.InsertAll(0, New[int](-1, 0))
+	assertListValues(t, list, "[-1 0 1 2 3 4]")
+	list.InsertAll(3, list)
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4]")
+	list.InsertAll(list.Size(), New[int](5))
+	list.InsertAll(list.Size()+1, New[int](6))
+	list.AddAll(New[int]())
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4 5]")
+}
+
+func TestListSubList(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4)
+	assertListValues(t, list.SubList(1, 3), "[1 2]")
+	assertListValues(t, list.SubList(0, 5), "[0 1 2 3 4]")
+	assertListValues(t, list.SubList(3, 3), "[]")
+	assertListValues(t, list.SubList(-1, 3), "[]")
+	assertListValues(t, list.SubList(2, 6), "[]")
+	subList := list.SubList(3, 5)
+	subList.Add(5)
+	assertListValues(t, subList, "[3 4 5]")
+	assertListValues(t, list, "[0 1 2 3 4]")
+}
+
+func TestListReverse(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	list.Reverse()
+	assertListValues(t, list, "[1]")
+	list.Add(2, 3, 4)
+	list.Reverse()
+	assertListValues(t, list, "[4 3 2 1]")
+	list.Add(0)
+	assertListValues(t, list, "[4 3 2 1 0]")
+}
+
+func TestListRotate(t *testing.T) {
+	list := New[int]()
+	list.Rotate(3)
+	assertListValues(t, list, "[]")
+	list.Add(0, 1, 2, 3, 4)
+	tests := [][]interface{}{
+		{0, "[0 1 2 3 4]"},
+		{1, "[4 0 1 2 3]"},
+		{2, "[2 3 4 0 1]"},
+		{4, "[3 4 0 1 2]"},
+		{-1, "[4 0 1 2 3]"},
+		{-3, "[2 3 4 0 1]"},
+		{12, "[0 1 2 3 4]"},
+		{-7, "[2 3 4 0 1]"},
+		{5, "[2 3 4 0 1]"},
+	}
+	for _, test := range tests {
+		list.Rotate(test[0].(int))
+		assertListValues(t, list, test[1].(string))
+	}
+	list.Add(5)
+	assertListValues(t, list, "[2 3 4 0 1 5]")
+}
+
+func TestListLastIndexOf(t *testing.T) {
+	list := New[int](1, 2, 1, 3, 2)
+	if actualValue, expectedValue := list.LastIndexOf(1), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(2), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(4), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListBinarySearch(t *testing.T) {
+	list := New[int](1, 3, 3, 5)
+	tests := [][]interface{}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{5, 3, true},
+		{6, 4, false},
+	}
+	for _, test := range tests {
+		index, found := list.BinarySearch(test[0].(int), utils.NumberComparator[int])
+		if index != test[1].(int) || found != test[2].(bool) {
+			t.Errorf("Got %v %v expected %v %v", index, found, test[1], test[2])
+		}
+	}
+	if index, found := New[int]().BinarySearch(1, utils.NumberComparator[int]); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+}
+
+func TestListHandles(t *testing.T) {
+	list := New[int]()
+	if actualValue := list.Front(); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	two := list.PushBack(2)
+	one := list.PushFront(1)
+	four := list.PushBack(4)
+	three := list.InsertBefore(3, four)
+	five := list.InsertAfter(5, four)
+	assertListValues(t, list, "[1 2 3 4 5]")
+	if actualValue, expectedValue := list.Front(), one; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue.Value, expectedValue.Value)
+	}
+	if actualValue, expectedValue := list.Back(), five; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue.Value, expectedValue.Value)
+	}
+	if actualValue, expectedValue := two.Next(), three; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue.Value, expectedValue.Value)
+	}
+	if actualValue, expectedValue := two.Prev(), one; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue.Value, expectedValue.Value)
+	}
+	list.MoveToFront(five)
+	assertListValues(t, list, "[5 1 2 3 4]")
+	list.MoveToBack(one)
+	assertListValues(t, list, "[5 2 3 4 1]")
+	list.MoveBefore(four, two)
+	assertListValues(t, list, "[5 4 2 3 1]")
+	list.MoveAfter(five, one)
+	assertListValues(t, list, "[4 2 3 1 5]")
+	list.MoveToFront(four)
+	list.MoveToBack(five)
+	list.MoveBefore(three, three)
+	list.MoveAfter(three, two)
+	assertListValues(t, list, "[4 2 3 1 5]")
+	if actualValue, ok := list.RemoveElement(three); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := list.RemoveElement(three); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	list.Remove(0)
+	list.RemoveElement(five)
+	assertListValues(t, list, "[2 1]")
+	list.MoveToFront(four)
+	list.MoveAfter(two, four)
+	if actualValue := list.InsertBefore(6, four); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	assertListValues(t, list, "[2 1]")
+}
+
+func TestListHandlesForeign(t *testing.T) {
+	list := New[int](1, 2, 3)
+	other := New[int]()
+	foreign := other.PushBack(4)
+	if actualValue := list.InsertAfter(5, foreign); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := list.InsertBefore(5, nil); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	list.MoveToFront(foreign)
+	list.MoveBefore(foreign, list.Back())
+	list.MoveAfter(list.Front(), foreign)
+	if _, ok := list.RemoveElement(foreign); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := list.RemoveElement(nil); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	assertListValues(t, list, "[1 2 3]")
+	assertListValues(t, other, "[4]")
+
+	first := list.Front()
+	list.RemoveRange(0, 1)
+	list.MoveToBack(first)
+	second := list.Front()
+	list.RemoveIf(func(value int) bool { return value == 2 })
+	list.MoveToBack(second)
+	last := list.Back()
+	list.Clear()
+	list.Add(7, 8)
+	list.MoveToFront(last)
+	assertListValues(t, list, "[7 8]")
+}
+
+func TestListSplice(t *testing.T) {
+	list := New[int](1, 5)
+	other := New[int]()
+	two := other.PushBack(2)
+	four := other.PushBack(4)
+	list.Splice(other, list.Back())
+	assertListValues(t, list, "[1 2 4 5]")
+	assertListValues(t, other, "[]")
+	list.InsertAfter(3, two)
+	list.MoveToFront(four)
+	assertListValues(t, list, "[4 1 2 3 5]")
+	if _, ok := other.RemoveElement(two); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	other.Add(6)
+	list.Splice(other, nil)
+	assertListValues(t, list, "[4 1 2 3 5 6]")
+	other.Add(-1, 0)
+	list.Splice(other, list.Front())
+	assertListValues(t, list, "[-1 0 4 1 2 3 5 6]")
+
+	// splicing the list with spliced elements moves their handles along
+	target := New[int](7)
+	target.Splice(list, target.Front())
+	assertListValues(t, target, "[-1 0 4 1 2 3 5 6 7]")
+	assertListValues(t, list, "[]")
+	target.MoveToBack(two)
+	target.MoveToBack(four)
+	assertListValues(t, target, "[-1 0 1 3 5 6 7 2 4]")
+	if _, ok := list.RemoveElement(two); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+
+	target.Splice(target, nil)
+	target.Splice(New[int](), nil)
+	target.Splice(New[int](8), New[int](9).Front())
+	assertListValues(t, target, "[-1 0 1 3 5 6 7 2 4]")
+	list.Splice(target, nil)
+	assertListValues(t, list, "[-1 0 1 3 5 6 7 2 4]")
+	if actualValue, ok := list.RemoveElement(four); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
+func TestListHandlesRandom(t *testing.T) {
+	list := New[int]()
+	var values []int
+	var elements []*Element[int]
+	indexOf := func(element *Element[int]) int {
+		for index, e := range elements {
+			if e == element {
+				return index
+			}
+		}
+		return -1
+	}
+	for i := 0; i < 2000; i++ {
+		switch operation := rand.Intn(6); {
+		case operation == 0 || len(elements) == 0:
+			elements = append(elements, list.PushBack(i))
+			values = append(values, i)
+		case operation == 1:
+			elements = append([]*Element[int]{list.PushFront(i)}, elements...)
+			values = append([]int{i}, values...)
+		case operation == 2:
+			index := rand.Intn(len(elements))
+			element := list.InsertAfter(i, elements[index])
+			elements = append(elements[:index+1], append([]*Element[int]{element}, elements[index+1:]...)...)
+			values = append(values[:index+1], append([]int{i}, values[index+1:]...)...)
+		case operation == 3:
+			index := rand.Intn(len(elements))
+			list.RemoveElement(elements[index])
+			elements = append(elements[:index], elements[index+1:]...)
+			values = append(values[:index], values[index+1:]...)
+		default:
+			element, mark := elements[rand.Intn(len(elements))], elements[rand.Intn(len(elements))]
+			if element == mark {
+				continue
+			}
+			list.MoveBefore(element, mark)
+			index := indexOf(element)
+			value := values[index]
+			elements = append(elements[:index], elements[index+1:]...)
+			values = append(values[:index], values[index+1:]...)
+			index = indexOf(mark)
+			elements = append(elements[:index], append([]*Element[int]{element}, elements[index:]...)...)
+			values = append(values[:index], append([]int{value}, values[index:]...)...)
+		}
+	}
+	if values == nil {
+		values = []int{}
+	}
+	assertListValues(t, list, fmt.Sprintf("%v", values))
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	list := New[string]("a", "b", "c")
+	it := list.Iterator()
+	it.Next()
+	list.Set(0, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	list.Remove(0) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Reverse()
+	assertConcurrentModification(func() { it.Next() })
+
+	it.First()
+	list.MoveToBack(list.Front())
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	list := New[string]("a", "b", "c", "d", "e")
+	it := list.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []string
+	for it.Next() {
+		value := it.Value()
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), value))
+		if value == "a" || value == "b" || value == "d" {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		} else if actualValue, expectedValue := it.Set(value+value), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[0:a 0:b 0:c 1:d 1:e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[cc ee]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add("f")
+	it.End()
+	visited = nil
+	for it.Prev() {
+		visited = append(visited, it.Value())
+		if it.Value() != "ee" {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[f ee cc]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[ee]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removing the last element, then moving in either direction
+	it.Last()
+	it.Remove()
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("g", "h")
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[g h]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDoublyLinkedListArena(t *testing.T) {
+	list, expected := NewWithArena[int](8), New[int]()
+	for i := 0; i < 1000; i++ {
+		switch i % 5 {
+		case 0, 1:
+			list.Add(i)
+			expected.Add(i)
+		case 2:
+			list.Prepend(i)
+			expected.Prepend(i)
+		case 3:
+			list.Remove(list.Size() / 2)
+			expected.Remove(expected.Size() / 2)
+		case 4:
+			list.Insert(list.Size()/3, i, i+1)
+			expected.Insert(expected.Size()/3, i, i+1)
+		}
+	}
+	list.RemoveIf(func(value int) bool { return value%7 == 0 })
+	expected.RemoveIf(func(value int) bool { return value%7 == 0 })
+	list.RemoveRange(10, 20)
+	expected.RemoveRange(10, 20)
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), fmt.Sprint(expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone := list.Clone()
+	list.Clear()
+	if actualValue, expectedValue := fmt.Sprint(clone.Values()), fmt.Sprint(expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removed elements are reused, so that refilling the list does not allocate
+	list.Add(1, 2, 3)
+	allocs := testing.AllocsPerRun(10, func() {
+		list.Remove(0)
+		list.Remove(0)
+		list.Remove(0)
+		list.Add(1)
+		list.Add(2)
+		list.Add(3)
+	})
+	if actualValue, expectedValue := allocs, 0.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removed elements are not reused once handles were handed out, so that a stale handle stays stale
+	list.Clear()
+	list.Add(1, 2, 3)
+	removed := list.PushBack(4)
+	list.RemoveElement(removed)
+	added := list.PushBack(5)
+	if added == removed {
+		t.Errorf("Got %p expected another element than %p", added, removed)
+	}
+	if _, ok := list.RemoveElement(removed); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	list.MoveToFront(removed)
+	list.MoveBefore(removed, added)
+	list.Remove(0)
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[2 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(removed.Value), "4"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListConformance(t *testing.T) {
+	t.Run("New", func(t *testing.T) {
+		containertest.TestList(t, func() lists.List[int] { return New[int]() })
+	})
+	t.Run("Arena", func(t *testing.T) {
+		containertest.TestList(t, func() lists.List[int] { return NewWithArena[int](2) })
+	})
+}
+
+// constructors of the lists under benchmark, allocating the elements one by one and from an arena
+var benchmarkLists = []struct {
+	name string
+	new  func() *List[int]
+}{
+	{"New", func() *List[int] { return New[int]() }},
+	{"Arena", func() *List[int] { return NewWithArena[int](1024) }},
+}
+
 func benchmarkGet(b *testing.B, list *List[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -704,130 +1412,190 @@ func benchmarkRemove(b *testing.B, list *List[int], size int) {
 }
 
 func BenchmarkDoublyLinkedListGet100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListGet1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListGet10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListGet100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListAdd100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
+	}
 }
 
 func BenchmarkDoublyLinkedListAdd1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListAdd10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListAdd100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListRemove100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListRemove1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListRemove10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkDoublyLinkedListRemove100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
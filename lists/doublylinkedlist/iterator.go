@@ -10,15 +10,18 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator holding the iterator's state
-type Iterator[T comparable] struct {
+type Iterator[T any] struct {
 	list    *List[T]
 	index   int
-	element *element[T]
+	element *Element[T]
+	version int
+	removed bool // current element was removed through the iterator
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the list is modified during iteration.
 func (list *List[T]) Iterator() Iterator[T] {
-	return Iterator[T]{list: list, index: -1, element: nil}
+	return Iterator[T]{list: list, index: -1, element: nil, version: list.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -26,6 +29,15 @@ func (list *List[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.list.version
+	}
+	iterator.checkVersion()
+	if iterator.removed {
+		// the iterator already holds the element that followed the removed one
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -45,6 +57,8 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
+	iterator.removed = false
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -63,7 +77,18 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	return iterator.element.value
+	iterator.checkVersion()
+	return iterator.element.Value
+}
+
+// Element returns the current element, nil if the iterator is not positioned on an element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Element() *Element[T] {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return nil
+	}
+	return iterator.list.handle(iterator.element)
 }
 
 // Index returns the current element's index.
@@ -77,6 +102,8 @@ func (iterator *Iterator[T]) Index() int {
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
 	iterator.element = nil
+	iterator.version = iterator.list.version
+	iterator.removed = false
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -84,6 +111,8 @@ func (iterator *Iterator[T]) Begin() {
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.list.size
 	iterator.element = iterator.list.last
+	iterator.version = iterator.list.version
+	iterator.removed = false
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -129,3 +158,40 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the list and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Value() is undefined until then.
+// Removing through the iterator is the only modification of the list that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	next := iterator.element.next
+	iterator.list.unlink(iterator.element)
+	iterator.list.freeElement(iterator.element)
+	iterator.element = next
+	iterator.version = iterator.list.version
+	iterator.removed = true
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.element.Value = value
+	return true
+}
+
+// checkVersion panics if the list was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.list.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -15,14 +15,27 @@ import (
 )
 
 // List interface that all lists implement
-type List[T comparable] interface {
+type List[T any] interface {
 	Get(index int) (T, bool)
 	Remove(index int)
+	RemoveRange(from, to int)
+	RemoveIf(f func(value T) bool) int
+	RetainIf(f func(value T) bool) int
 	Add(values ...T)
+	AddAll(other List[T])
 	Contains(values ...T) bool
+	ContainsFunc(f func(value T) bool) bool
+	IndexOf(value T) int
+	IndexOfFunc(f func(value T) bool) int
+	LastIndexOf(value T) int
+	BinarySearch(value T, comparator utils.Comparator[T]) (int, bool)
 	Sort(comparator utils.Comparator[T])
+	SortStable(comparator utils.Comparator[T])
 	Swap(index1, index2 int)
+	Reverse()
+	Rotate(k int)
 	Insert(index int, values ...T)
+	InsertAll(index int, other List[T])
 	Set(index int, value T)
 
 	containers.Container[T]
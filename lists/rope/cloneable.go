@@ -0,0 +1,39 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*List[int], int] = (*List[int])(nil)
+
+// Clone returns a copy of the list holding the same elements in the same order in O(1).
+// Both lists share their nodes, which are copied on modification.
+func (list *List[T]) Clone() *List[T] {
+	return &List[T]{root: list.root, equality: list.equality}
+}
+
+// DeepClone returns a copy of the list where every element is passed through the given copy function.
+func (list *List[T]) DeepClone(copyValue func(value T) T) *List[T] {
+	values := list.Values()
+	for index, value := range values {
+		values[index] = copyValue(value)
+	}
+	return &List[T]{root: build(values), equality: list.equality}
+}
+
+// Equal returns true if both lists hold equal elements in the same order.
+func (list *List[T]) Equal(other *List[T]) bool {
+	if list.Size() != other.Size() {
+		return false
+	}
+	iterator, otherIterator := list.Iterator(), other.Iterator()
+	for iterator.Next() && otherIterator.Next() {
+		if !list.equal(iterator.Value(), otherIterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[T any] struct {
+	list    *List[T]
+	index   int
+	version int
+	removed bool     // current element was removed through the iterator
+	root    *node[T] // root of the list when the leaf was looked up
+	leaf    []T      // elements of the leaf holding the current element
+	start   int      // index of the first element of the leaf
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// Moving the iterator to an adjacent element takes amortized O(1) time, as the leaf of the current element is cached.
+// The iterator panics with containers.ErrConcurrentModification if the list is modified during iteration.
+func (list *List[T]) Iterator() Iterator[T] {
+	return Iterator[T]{list: list, index: -1, version: list.version}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.list.version
+	}
+	iterator.checkVersion()
+	if iterator.removed {
+		// the element that followed the removed one has moved to its index
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
+
+	if iterator.index < iterator.list.Size() {
+		iterator.index++
+	}
+	return iterator.list.withinRange(iterator.index)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
+	iterator.removed = false
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.list.withinRange(iterator.index)
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
+	if iterator.root != iterator.list.root || iterator.index < iterator.start || iterator.index >= iterator.start+len(iterator.leaf) {
+		iterator.root = iterator.list.root
+		iterator.leaf, iterator.start = iterator.list.root.leaf(iterator.index)
+	}
+	return iterator.leaf[iterator.index-iterator.start]
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T]) Begin() {
+	iterator.index = -1
+	iterator.version = iterator.list.version
+	iterator.removed = false
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[T]) End() {
+	iterator.index = iterator.list.Size()
+	iterator.version = iterator.list.version
+	iterator.removed = false
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the current element from the list and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Value() is undefined until then.
+// Removing through the iterator is the only modification of the list that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.list.Remove(iterator.index)
+	iterator.version = iterator.list.version
+	iterator.removed = true
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.list.Set(iterator.index, value)
+	return true
+}
+
+// checkVersion panics if the list was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.list.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
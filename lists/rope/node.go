@@ -0,0 +1,177 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import "slices"
+
+// leafSize is the maximum number of elements held by a leaf
+const leafSize = 128
+
+// node is either a leaf holding a chunk of the elements or an inner node concatenating its two children.
+// Nodes are never modified once built, so that ropes can share them. The heights of the children of every inner
+// node differ by at most one, as in an AVL tree, which keeps the height of a rope logarithmic in its size.
+type node[T any] struct {
+	left, right *node[T]
+	elements    []T // elements of a leaf, nil for inner nodes
+	size        int // number of elements in the subtree
+	height      int // one for leaves
+}
+
+// newLeaf returns a leaf holding the elements, which must not be modified afterwards.
+func newLeaf[T any](elements []T) *node[T] {
+	return &node[T]{elements: elements, size: len(elements), height: 1}
+}
+
+// newInner returns an inner node concatenating the subtrees, whose heights have to differ by at most one.
+func newInner[T any](left, right *node[T]) *node[T] {
+	return &node[T]{left: left, right: right, size: left.size + right.size, height: max(left.height, right.height) + 1}
+}
+
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// build returns a balanced subtree holding a copy of the values, whose leaves are at least half full.
+func build[T any](values []T) *node[T] {
+	switch {
+	case len(values) == 0:
+		return nil
+	case len(values) <= leafSize:
+		return newLeaf(slices.Clone(values))
+	}
+	middle := len(values) / 2
+	return newInner(build(values[:middle]), build(values[middle:]))
+}
+
+// concat returns the concatenation of the subtrees in O(log n). The leaves at the boundary of the subtrees
+// are merged if they fit into one, so that inserting and removing single elements does not fragment the leaves.
+func concat[T any](left, right *node[T]) *node[T] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	last, first := left.lastLeaf(), right.firstLeaf()
+	if len(last)+len(first) > leafSize {
+		return join(left, right)
+	}
+	left, _ = split(left, left.size-len(last))
+	_, right = split(right, len(first))
+	merged := make([]T, 0, len(last)+len(first))
+	merged = append(append(merged, last...), first...)
+	return join(join(left, newLeaf(merged)), right)
+}
+
+// join returns the concatenation of the subtrees in time proportional to the difference of their heights,
+// descending along the facing spine of the higher subtree and rebalancing on the way up.
+func join[T any](left, right *node[T]) *node[T] {
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	case left.height > right.height+1:
+		return balance(left.left, join(left.right, right))
+	case right.height > left.height+1:
+		return balance(join(left, right.left), right.right)
+	default:
+		return newInner(left, right)
+	}
+}
+
+// balance returns an inner node concatenating the subtrees, whose heights differ by at most two,
+// rotating them like an AVL tree if they differ by two.
+func balance[T any](left, right *node[T]) *node[T] {
+	switch {
+	case left.height > right.height+1:
+		if left.left.height >= left.right.height {
+			return newInner(left.left, newInner(left.right, right))
+		}
+		return newInner(newInner(left.left, left.right.left), newInner(left.right.right, right))
+	case right.height > left.height+1:
+		if right.right.height >= right.left.height {
+			return newInner(newInner(left, right.left), right.right)
+		}
+		return newInner(newInner(left, right.left.left), newInner(right.left.right, right.right))
+	default:
+		return newInner(left, right)
+	}
+}
+
+// split returns the subtrees holding the first index elements and the remaining elements in O(log n).
+func split[T any](n *node[T], index int) (*node[T], *node[T]) {
+	switch {
+	case n == nil || index <= 0:
+		return nil, n
+	case index >= n.size:
+		return n, nil
+	case n.elements != nil:
+		return newLeaf(n.elements[:index:index]), newLeaf(n.elements[index:])
+	case index < n.left.size:
+		left, right := split(n.left, index)
+		return left, join(right, n.right)
+	case index > n.left.size:
+		left, right := split(n.right, index-n.left.size)
+		return join(n.left, left), right
+	default:
+		return n.left, n.right
+	}
+}
+
+// leaf returns the elements of the leaf holding the element at the index and the index of its first element.
+func (n *node[T]) leaf(index int) ([]T, int) {
+	start := 0
+	for n.elements == nil {
+		if index-start < n.left.size {
+			n = n.left
+		} else {
+			start += n.left.size
+			n = n.right
+		}
+	}
+	return n.elements, start
+}
+
+// set returns a copy of the subtree where the element at the index is replaced by the value.
+func (n *node[T]) set(index int, value T) *node[T] {
+	if n.elements != nil {
+		elements := slices.Clone(n.elements)
+		elements[index] = value
+		return newLeaf(elements)
+	}
+	if index < n.left.size {
+		return newInner(n.left.set(index, value), n.right)
+	}
+	return newInner(n.left, n.right.set(index-n.left.size, value))
+}
+
+func (n *node[T]) firstLeaf() []T {
+	for n.elements == nil {
+		n = n.left
+	}
+	return n.elements
+}
+
+func (n *node[T]) lastLeaf() []T {
+	for n.elements == nil {
+		n = n.right
+	}
+	return n.elements
+}
+
+// appendTo appends the elements of the subtree in order to the slice.
+func (n *node[T]) appendTo(values []T) []T {
+	if n == nil {
+		return values
+	}
+	if n.elements != nil {
+		return append(values, n.elements...)
+	}
+	return n.right.appendTo(n.left.appendTo(values))
+}
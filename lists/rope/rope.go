@@ -0,0 +1,357 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rope implements a rope, a list backed by a balanced tree whose leaves hold chunks of the elements.
+//
+// Getting, setting, inserting and removing elements at any index, as well as concatenating, splitting and slicing
+// ropes, takes O(log n) time, where an array list has to shift O(n) elements. The nodes of the tree are immutable
+// and shared between ropes, so that clones, slices and splits take O(log n) time and space as well.
+// String is a rope of runes specialized for text editing.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Rope_(data_structure)
+package rope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ugurcsen/gods-generic/lists"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert List implementation
+var _ lists.List[int] = (*List[int])(nil)
+
+// List holds the elements in a balanced tree of leaves
+type List[T any] struct {
+	root     *node[T]
+	equality utils.Equality[T]
+	version  int // incremented on every modification, used by iterators to detect concurrent modification
+}
+
+// New instantiates a new list and adds the passed values, if any, to the list
+func New[T any](values ...T) *List[T] {
+	return &List[T]{root: build(values), equality: utils.DefaultEquality[T]()}
+}
+
+// NewWithEquality instantiates a new list that compares values with the given equality
+// (used by Contains, IndexOf and Equal) and adds the passed values, if any, to the list
+func NewWithEquality[T any](equality utils.Equality[T], values ...T) *List[T] {
+	return &List[T]{root: build(values), equality: equality}
+}
+
+// Add appends a value at the end of the list
+func (list *List[T]) Add(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.version++
+	list.root = concat(list.root, build(values))
+}
+
+// Get returns the element at index in O(log n).
+// Second return parameter is true if index is within bounds of the list and list is not empty, otherwise false.
+func (list *List[T]) Get(index int) (T, bool) {
+	if !list.withinRange(index) {
+		var emptyT T
+		return emptyT, false
+	}
+	elements, start := list.root.leaf(index)
+	return elements[index-start], true
+}
+
+// Remove removes the element at the given index from the list in O(log n).
+func (list *List[T]) Remove(index int) {
+	list.RemoveRange(index, index+1)
+}
+
+// RemoveRange removes the elements from index from (inclusive) to index to (exclusive) in O(log n).
+// Does not do anything if the range is not within bounds of the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.Size() || from >= to {
+		return
+	}
+	list.version++
+	left, rest := split(list.root, from)
+	_, right := split(rest, to-from)
+	list.root = concat(left, right)
+}
+
+// RemoveIf removes all elements satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RemoveIf(f func(value T) bool) int {
+	values := list.Values()
+	kept := values[:0]
+	for _, value := range values {
+		if !f(value) {
+			kept = append(kept, value)
+		}
+	}
+	removed := len(values) - len(kept)
+	if removed > 0 {
+		list.version++
+		list.root = build(kept)
+	}
+	return removed
+}
+
+// RetainIf removes all elements not satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RetainIf(f func(value T) bool) int {
+	return list.RemoveIf(func(value T) bool { return !f(value) })
+}
+
+// AddAll appends all elements of the other list at the end of the list.
+// Takes O(log n) time if the other list is a rope, which then shares its nodes with the list.
+func (list *List[T]) AddAll(other lists.List[T]) {
+	list.InsertAll(list.Size(), other)
+}
+
+// InsertAll inserts all elements of the other list at specified index position shifting the value at that position
+// (if any) and any subsequent elements to the right.
+// Takes O(log n) time if the other list is a rope, which then shares its nodes with the list.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) InsertAll(index int, other lists.List[T]) {
+	if rope, ok := other.(*List[T]); ok {
+		list.insert(index, rope.root)
+		return
+	}
+	list.Insert(index, other.Values()...)
+}
+
+// Concat appends all elements of the other list at the end of the list in O(log n).
+// The other list is not modified, both lists share their nodes afterwards.
+func (list *List[T]) Concat(other *List[T]) {
+	list.insert(list.Size(), other.root)
+}
+
+// Split returns a list holding the elements before the index and a list holding the element at the index and all
+// subsequent elements in O(log n). The list is not modified, all three lists share their nodes afterwards.
+// The index is clamped to the bounds of the list.
+func (list *List[T]) Split(index int) (*List[T], *List[T]) {
+	left, right := split(list.root, index)
+	return &List[T]{root: left, equality: list.equality}, &List[T]{root: right, equality: list.equality}
+}
+
+// Slice returns a new list holding the elements from index from (inclusive) to index to (exclusive) in O(log n).
+// The list is not modified, both lists share their nodes afterwards.
+// Returns an empty list if the range is not within bounds of the list.
+func (list *List[T]) Slice(from, to int) *List[T] {
+	newList := &List[T]{equality: list.equality}
+	if from < 0 || to > list.Size() || from >= to {
+		return newList
+	}
+	_, rest := split(list.root, from)
+	newList.root, _ = split(rest, to-from)
+	return newList
+}
+
+// Reverse reverses the order of the elements in O(n).
+func (list *List[T]) Reverse() {
+	if list.Size() < 2 {
+		return
+	}
+	list.version++
+	values := list.Values()
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+	list.root = build(values)
+}
+
+// Rotate rotates the elements by k positions to the right in O(log n), i.e. the last k elements are moved to the
+// front of the list. Negative k rotates to the left.
+func (list *List[T]) Rotate(k int) {
+	size := list.Size()
+	if size < 2 {
+		return
+	}
+	k = (k%size + size) % size
+	if k == 0 {
+		return
+	}
+	list.version++
+	left, right := split(list.root, size-k)
+	list.root = concat(right, left)
+}
+
+// Contains checks if elements (one or more) are present in the set.
+// All elements have to be present in the set for the method to return true.
+// Performance time complexity of n^2.
+// Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
+func (list *List[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if list.IndexOf(value) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsFunc checks if any element of the list satisfies the given function.
+func (list *List[T]) ContainsFunc(f func(value T) bool) bool {
+	return list.IndexOfFunc(f) >= 0
+}
+
+// Values returns all elements in the list.
+func (list *List[T]) Values() []T {
+	return list.root.appendTo(make([]T, 0, list.Size()))
+}
+
+// IndexOf returns index of provided element, or -1 if the list does not contain it
+func (list *List[T]) IndexOf(value T) int {
+	return list.IndexOfFunc(func(element T) bool { return list.equal(element, value) })
+}
+
+// IndexOfFunc returns index of the first element satisfying the given function, or -1 if there is none
+func (list *List[T]) IndexOfFunc(f func(value T) bool) int {
+	for index := 0; index < list.Size(); {
+		elements, _ := list.root.leaf(index)
+		for _, element := range elements {
+			if f(element) {
+				return index
+			}
+			index++
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns index of the last occurrence of provided element, or -1 if the list does not contain it
+func (list *List[T]) LastIndexOf(value T) int {
+	for index := list.Size() - 1; index >= 0; {
+		elements, start := list.root.leaf(index)
+		for ; index >= start; index-- {
+			if list.equal(elements[index-start], value) {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+// BinarySearch searches for the value in the list sorted in ascending order with respect to the given comparator.
+// Returns the index of the first element equal to the value and true if found,
+// otherwise the index where the value would be inserted to keep the list sorted and false.
+// Performance time complexity of log^2 n.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (int, bool) {
+	low, high := 0, list.Size()
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if element, _ := list.Get(middle); comparator(element, value) < 0 {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	if element, ok := list.Get(low); ok && comparator(element, value) == 0 {
+		return low, true
+	}
+	return low, false
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List[T]) Empty() bool {
+	return list.root == nil
+}
+
+// Size returns number of elements within the list.
+func (list *List[T]) Size() int {
+	return size(list.root)
+}
+
+// Clear removes all elements from the list.
+func (list *List[T]) Clear() {
+	list.version++
+	list.root = nil
+}
+
+// Sort sorts values using.
+func (list *List[T]) Sort(comparator utils.Comparator[T]) {
+	if list.Size() < 2 {
+		return
+	}
+	list.version++
+	values := list.Values()
+	utils.Sort(values, comparator)
+	list.root = build(values)
+}
+
+// SortStable sorts values keeping the order of equal values.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
+	if list.Size() < 2 {
+		return
+	}
+	list.version++
+	values := list.Values()
+	utils.SortStable(values, comparator)
+	list.root = build(values)
+}
+
+// Swap swaps the two values at the specified positions.
+func (list *List[T]) Swap(i, j int) {
+	if list.withinRange(i) && list.withinRange(j) && i != j {
+		list.version++
+		a, _ := list.Get(i)
+		b, _ := list.Get(j)
+		list.root = list.root.set(i, b).set(j, a)
+	}
+}
+
+// Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent
+// elements to the right in O(log n + m), where m is the number of inserted values.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Insert(index int, values ...T) {
+	list.insert(index, build(values))
+}
+
+// Set the value at specified index in O(log n)
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) Set(index int, value T) {
+	if !list.withinRange(index) {
+		// Append
+		if index == list.Size() {
+			list.Add(value)
+		}
+		return
+	}
+	list.root = list.root.set(index, value)
+}
+
+// String returns a string representation of container
+func (list *List[T]) String() string {
+	str := "Rope\n"
+	values := []string{}
+	for _, value := range list.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Check that the index is within bounds of the list
+func (list *List[T]) withinRange(index int) bool {
+	return index >= 0 && index < list.Size()
+}
+
+// Insert the subtree at the index, does not do anything if the index is out of bounds or the subtree is empty
+func (list *List[T]) insert(index int, n *node[T]) {
+	if index < 0 || index > list.Size() || n == nil {
+		return
+	}
+	list.version++
+	left, right := split(list.root, index)
+	list.root = concat(concat(left, n), right)
+}
+
+// Compare two values with the list's equality, utils.DefaultEquality is used if none was given
+func (list *List[T]) equal(a, b T) bool {
+	if list.equality == nil {
+		return utils.DefaultEquality[T]()(a, b)
+	}
+	return list.equality(a, b)
+}
@@ -0,0 +1,1428 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/lists"
+	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestListNew(t *testing.T) {
+	list1 := New[string]()
+
+	if actualValue := list1.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	list2 := New[string]("a", "b")
+
+	if actualValue := list2.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	if actualValue, ok := list2.Get(0); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	if actualValue, ok := list2.Get(1); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	var empty string
+	if actualValue, ok := list2.Get(2); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestListAdd(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue := list.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := list.Get(2); actualValue != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestListIndexOf(t *testing.T) {
+	list := New[string]()
+
+	expectedIndex := -1
+	if index := list.IndexOf("a"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	list.Add("a")
+	list.Add("b", "c")
+
+	expectedIndex = 0
+	if index := list.IndexOf("a"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	expectedIndex = 1
+	if index := list.IndexOf("b"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+
+	expectedIndex = 2
+	if index := list.IndexOf("c"); index != expectedIndex {
+		t.Errorf("Got %v expected %v", index, expectedIndex)
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	list.Remove(2)
+	var empty string
+	if actualValue, ok := list.Get(2); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	list.Remove(1)
+	list.Remove(0)
+	list.Remove(0) // no effect
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListGet(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue, ok := list.Get(0); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := list.Get(1); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := list.Get(2); actualValue != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	var empty string
+	if actualValue, ok := list.Get(3); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	list.Remove(0)
+	if actualValue, ok := list.Get(0); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestListSwap(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	list.Swap(0, 1)
+	if actualValue, ok := list.Get(0); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+}
+
+func TestListSort(t *testing.T) {
+	list := New[string]()
+	list.Sort(utils.StringComparator)
+	list.Add("e", "f", "g", "a", "b", "c", "d")
+	list.Sort(utils.StringComparator)
+	for i := 1; i < list.Size(); i++ {
+		a, _ := list.Get(i - 1)
+		b, _ := list.Get(i)
+		if a > b {
+			t.Errorf("Not sorted! %s > %s", a, b)
+		}
+	}
+}
+
+func TestListClear(t *testing.T) {
+	list := New[string]()
+	list.Add("e", "f", "g", "a", "b", "c", "d")
+	list.Clear()
+	if actualValue := list.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestListContains(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+	if actualValue := list.Contains("a"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	var empty string
+	if actualValue := list.Contains(empty); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Contains("a", "b", "c", "d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	list.Clear()
+	if actualValue := list.Contains("a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Contains("a", "b", "c"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestListValues(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b", "c")
+
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListInsert(t *testing.T) {
+	list := New[string]()
+	list.Insert(0, "b", "c")
+	list.Insert(0, "a")
+	list.Insert(10, "x") // ignore
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	list.Insert(3, "d") // append
+	if actualValue := list.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abcd"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSet(t *testing.T) {
+	list := New[string]()
+	list.Set(0, "a")
+	list.Set(1, "b")
+	if actualValue := list.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	list.Set(2, "c") // append
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	list.Set(4, "d")  // ignore
+	list.Set(1, "bb") // update
+	if actualValue := list.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abbc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListEach(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	list.Each(func(index int, value string) {
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	})
+}
+
+func TestListMap(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	mappedList := list.Map(func(index int, value string) string {
+		return "mapped: " + value
+	})
+	if actualValue, _ := mappedList.Get(0); actualValue != "mapped: a" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
+	}
+	if actualValue, _ := mappedList.Get(1); actualValue != "mapped: b" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: b")
+	}
+	if actualValue, _ := mappedList.Get(2); actualValue != "mapped: c" {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
+	}
+	if mappedList.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedList.Size(), 3)
+	}
+}
+
+func TestListSelect(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	selectedList := list.Select(func(index int, value string) bool {
+		return value >= "a" && value <= "b"
+	})
+	if actualValue, _ := selectedList.Get(0); actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "value: a")
+	}
+	if actualValue, _ := selectedList.Get(1); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "value: b")
+	}
+	if selectedList.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedList.Size(), 3)
+	}
+}
+
+func TestListAny(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	any := list.Any(func(index int, value string) bool {
+		return value == "c"
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = list.Any(func(index int, value string) bool {
+		return value == "x"
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+func TestListAll(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	all := list.All(func(index int, value string) bool {
+		return value >= "a" && value <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = list.All(func(index int, value string) bool {
+		return value >= "a" && value <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+func TestListFind(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	foundIndex, foundValue := list.Find(func(index int, value string) bool {
+		return value == "c"
+	})
+	if foundValue != "c" || foundIndex != 2 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, "c", 2)
+	}
+	foundIndex, foundValue = list.Find(func(index int, value string) bool {
+		return value == "x"
+	})
+	var emptyValue string
+	if foundValue != emptyValue || foundIndex != -1 {
+		t.Errorf("Got %v at %v expected %v at %v", foundValue, foundIndex, nil, nil)
+	}
+}
+func TestListChaining(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	chainedList := list.Select(func(index int, value string) bool {
+		return value > "a"
+	}).Map(func(index int, value string) string {
+		return value + value
+	})
+	if chainedList.Size() != 2 {
+		t.Errorf("Got %v expected %v", chainedList.Size(), 2)
+	}
+	if actualValue, ok := chainedList.Get(0); actualValue != "bb" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := chainedList.Get(1); actualValue != "cc" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestListIteratorNextOnEmpty(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty list")
+	}
+}
+
+func TestListIteratorNext(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	it := list.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorPrevOnEmpty(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty list")
+	}
+}
+
+func TestListIteratorPrev(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	it := list.Iterator()
+	for it.Next() {
+	}
+	count := 0
+	for it.Prev() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIteratorBegin(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	it.Begin()
+	list.Add("a", "b", "c")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if index, value := it.Index(), it.Value(); index != 0 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "a")
+	}
+}
+
+func TestListIteratorEnd(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+
+	if index := it.Index(); index != -1 {
+		t.Errorf("Got %v expected %v", index, -1)
+	}
+
+	it.End()
+	if index := it.Index(); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+
+	list.Add("a", "b", "c")
+	it.End()
+	if index := it.Index(); index != list.Size() {
+		t.Errorf("Got %v expected %v", index, list.Size())
+	}
+
+	it.Prev()
+	if index, value := it.Index(), it.Value(); index != list.Size()-1 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, list.Size()-1, "c")
+	}
+}
+
+func TestListIteratorFirst(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("a", "b", "c")
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "a")
+	}
+}
+
+func TestListIteratorLast(t *testing.T) {
+	list := New[string]()
+	it := list.Iterator()
+	if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("a", "b", "c")
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "c")
+	}
+}
+
+func TestListIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// NextTo (empty)
+	{
+		list := New[string]()
+		it := list.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		list := New[string]()
+		list.Add("xx", "yy")
+		it := list.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// NextTo (found)
+	{
+		list := New[string]()
+		list.Add("aa", "bb", "cc")
+		it := list.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 2 || value != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "cc")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestListIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// PrevTo (empty)
+	{
+		list := New[string]()
+		it := list.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		list := New[string]()
+		list.Add("xx", "yy")
+		it := list.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		list := New[string]()
+		list.Add("aa", "bb", "cc")
+		it := list.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty list")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 0 || value != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "aa")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+func TestListSerialization(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abc"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := list.ToJSON()
+	assert()
+
+	err = list.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", list})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	list2 := New[int]()
+	err = json.Unmarshal([]byte(`[1,2,3]`), &list2)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestListString(t *testing.T) {
+	c := New[int]()
+	c.Add(1)
+	if !strings.HasPrefix(c.String(), "Rope") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestListClone(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	clone := list.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(clone.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Set(0, "x")
+	clone.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := list.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(deepClone.Values())...), "ABC"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	empty := New[string]().Clone()
+	if actualValue := empty.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	if actualValue := list.Equal(list.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "b", "c")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "c", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[string]().Equal(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListIndexOfFunc(t *testing.T) {
+	list := New[int](1, 2, 3, 4)
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value%2 == 0 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value > 4 }), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 4 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNonComparable(t *testing.T) {
+	list := New[[]int]([]int{1, 2}, []int{3})
+	list.Add(nil)
+	if actualValue, expectedValue := list.IndexOf([]int{3}), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1, 2}, nil), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(list.Clone()), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNewWithEquality(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	list := NewWithEquality(sameLength, "a", "bb", "ccc")
+	if actualValue, expectedValue := list.IndexOf("xx"), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains("x", "yyy"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := list.Select(func(index int, value string) bool { return index > 0 })
+	if actualValue, expectedValue := selected.Contains("zz"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(NewWithEquality(sameLength, "x", "yy", "zzz")), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIndexOfBeyondSize(t *testing.T) {
+	list := New[int](1, 2, 3)
+	list.Remove(2)
+	if actualValue, expectedValue := list.IndexOf(0), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSortStable(t *testing.T) {
+	list := New[string]("b2", "a1", "b1", "c1", "a2", "a3")
+	list.SortStable(func(a, b string) int { return utils.ByteComparator(a[0], b[0]) })
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d1")
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1 d1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func assertListValues(t *testing.T, list *List[int], expected string) {
+	t.Helper()
+	if actualValue := fmt.Sprintf("%v", list.Values()); actualValue != expected {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue, expectedValue := list.Size(), len(strings.Fields(strings.Trim(expected, "[]"))); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6)
+	list.RemoveRange(-1, 2)
+	list.RemoveRange(2, 8)
+	list.RemoveRange(3, 3)
+	assertListValues(t, list, "[0 1 2 3 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[0 1 4 5 6]")
+	list.RemoveRange(0, 1)
+	assertListValues(t, list, "[1 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[1 4]")
+	list.RemoveRange(0, 2)
+	assertListValues(t, list, "[]")
+	list.Add(7, 8)
+	assertListValues(t, list, "[7 8]")
+}
+
+func TestListRemoveIf(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	isEven := func(value int) bool { return value%2 == 0 }
+	if actualValue, expectedValue := list.RemoveIf(isEven), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[1 3 5 7]")
+	if actualValue, expectedValue := list.RetainIf(func(value int) bool { return value > 2 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[3 5 7]")
+	if actualValue, expectedValue := list.RemoveIf(isEven), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RetainIf(isEven), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	assertListValues(t, list, "[1]")
+}
+
+func TestListAddAllInsertAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	assertListValues(t, list, "[1 2 3 4]")
+	list.InsertAll(0, New[int](-1, 0))
+	assertListValues(t, list, "[-1 0 1 2 3 4]")
+	list.InsertAll(3, list)
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4]")
+	list.InsertAll(list.Size(), New[int](5))
+	list.InsertAll(list.Size()+1, New[int](6))
+	list.AddAll(New[int]())
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4 5]")
+}
+
+func TestListSlice(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4)
+	assertListValues(t, list.Slice(1, 3), "[1 2]")
+	assertListValues(t, list.Slice(0, 5), "[0 1 2 3 4]")
+	assertListValues(t, list.Slice(3, 3), "[]")
+	assertListValues(t, list.Slice(-1, 3), "[]")
+	assertListValues(t, list.Slice(2, 6), "[]")
+	slice := list.Slice(3, 5)
+	slice.Add(5)
+	assertListValues(t, slice, "[3 4 5]")
+	assertListValues(t, list, "[0 1 2 3 4]")
+}
+
+func TestListReverse(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	list.Reverse()
+	assertListValues(t, list, "[1]")
+	list.Add(2, 3, 4)
+	list.Reverse()
+	assertListValues(t, list, "[4 3 2 1]")
+	list.Add(0)
+	assertListValues(t, list, "[4 3 2 1 0]")
+}
+
+func TestListRotate(t *testing.T) {
+	list := New[int]()
+	list.Rotate(3)
+	assertListValues(t, list, "[]")
+	list.Add(0, 1, 2, 3, 4)
+	tests := [][]interface{}{
+		{0, "[0 1 2 3 4]"},
+		{1, "[4 0 1 2 3]"},
+		{2, "[2 3 4 0 1]"},
+		{4, "[3 4 0 1 2]"},
+		{-1, "[4 0 1 2 3]"},
+		{-3, "[2 3 4 0 1]"},
+		{12, "[0 1 2 3 4]"},
+		{-7, "[2 3 4 0 1]"},
+		{5, "[2 3 4 0 1]"},
+	}
+	for _, test := range tests {
+		list.Rotate(test[0].(int))
+		assertListValues(t, list, test[1].(string))
+	}
+	list.Add(5)
+	assertListValues(t, list, "[2 3 4 0 1 5]")
+}
+
+func TestListLastIndexOf(t *testing.T) {
+	list := New[int](1, 2, 1, 3, 2)
+	if actualValue, expectedValue := list.LastIndexOf(1), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(2), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(4), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListBinarySearch(t *testing.T) {
+	list := New[int](1, 3, 3, 5)
+	tests := [][]interface{}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{5, 3, true},
+		{6, 4, false},
+	}
+	for _, test := range tests {
+		index, found := list.BinarySearch(test[0].(int), utils.NumberComparator[int])
+		if index != test[1].(int) || found != test[2].(bool) {
+			t.Errorf("Got %v %v expected %v %v", index, found, test[1], test[2])
+		}
+	}
+	if index, found := New[int]().BinarySearch(1, utils.NumberComparator[int]); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	list := New[string]("a", "b", "c")
+	it := list.Iterator()
+	it.Next()
+	list.Set(0, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	list.Remove(0) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Reverse()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	list := New[string]("a", "b", "c", "d", "e")
+	it := list.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []string
+	for it.Next() {
+		value := it.Value()
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), value))
+		if value == "a" || value == "b" || value == "d" {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		} else if actualValue, expectedValue := it.Set(value+value), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[0:a 0:b 0:c 1:d 1:e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[cc ee]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add("f")
+	it.End()
+	visited = nil
+	for it.Prev() {
+		visited = append(visited, it.Value())
+		if it.Value() != "ee" {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[f ee cc]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[ee]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removing the last element, then moving in either direction
+	it.Last()
+	it.Remove()
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("g", "h")
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[g h]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// checkNode checks the sizes, heights and balance of the subtree and the sizes of its leaves
+func checkNode[T any](t *testing.T, n *node[T]) {
+	t.Helper()
+	if n == nil {
+		return
+	}
+	if n.elements != nil {
+		if n.left != nil || n.right != nil || n.size != len(n.elements) || n.height != 1 || n.size > leafSize {
+			t.Fatalf("Invalid leaf of size %v and height %v", n.size, n.height)
+		}
+		return
+	}
+	if n.left == nil || n.right == nil {
+		t.Fatalf("Inner node without two children")
+	}
+	checkNode(t, n.left)
+	checkNode(t, n.right)
+	if n.size != n.left.size+n.right.size {
+		t.Fatalf("Got size %v expected %v", n.size, n.left.size+n.right.size)
+	}
+	if n.height != max(n.left.height, n.right.height)+1 {
+		t.Fatalf("Got height %v expected %v", n.height, max(n.left.height, n.right.height)+1)
+	}
+	if diff := n.left.height - n.right.height; diff < -1 || diff > 1 {
+		t.Fatalf("Unbalanced node with heights %v and %v", n.left.height, n.right.height)
+	}
+}
+
+func assertRope(t *testing.T, list *List[int], expected []int) {
+	t.Helper()
+	checkNode(t, list.root)
+	if actualValue, expectedValue := list.Size(), len(expected); actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !slices.Equal(list.Values(), expected) {
+		t.Fatalf("Got %v expected %v", list.Values(), expected)
+	}
+}
+
+func TestListRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	list, model := New[int](), []int{}
+	for i := 0; i < 3000; i++ {
+		index := r.Intn(len(model) + 1)
+		switch r.Intn(6) {
+		case 0, 1:
+			values := make([]int, r.Intn(300))
+			for j := range values {
+				values[j] = r.Int()
+			}
+			list.Insert(index, values...)
+			model = slices.Insert(model, index, values...)
+		case 2:
+			to := min(len(model), index+r.Intn(200))
+			list.RemoveRange(index, to)
+			if index < to {
+				model = slices.Delete(model, index, to)
+			}
+		case 3:
+			left, right := list.Split(index)
+			assertRope(t, left, model[:index])
+			assertRope(t, right, model[index:])
+			right.Concat(left)
+			list = right
+			model = append(slices.Clone(model[index:]), model[:index]...)
+		case 4:
+			list.Set(index, -i)
+			if index < len(model) {
+				model[index] = -i
+			} else {
+				model = append(model, -i)
+			}
+		case 5:
+			list.Remove(index)
+			if index < len(model) {
+				model = slices.Delete(model, index, index+1)
+			}
+		}
+		if index < len(model) {
+			if actualValue, ok := list.Get(index); actualValue != model[index] || !ok {
+				t.Fatalf("Got %v expected %v", actualValue, model[index])
+			}
+		}
+		assertRope(t, list, model)
+	}
+}
+
+func TestListSingleInsertions(t *testing.T) {
+	list, model := New[int](), []int{}
+	for i := 0; i < 10000; i++ {
+		index := (i * 7919) % (len(model) + 1)
+		list.Insert(index, i)
+		model = slices.Insert(model, index, i)
+	}
+	assertRope(t, list, model)
+	// merging the boundary leaves keeps single insertions from fragmenting the rope into tiny leaves
+	if actualValue, expectedValue := list.root.height, 12; actualValue > expectedValue {
+		t.Errorf("Got height %v expected at most %v", actualValue, expectedValue)
+	}
+	for len(model) > 0 {
+		index := (len(model) * 7919) % len(model)
+		list.Remove(index)
+		model = slices.Delete(model, index, index+1)
+		if len(model)%1000 == 0 {
+			assertRope(t, list, model)
+		}
+	}
+	assertRope(t, list, model)
+}
+
+func TestListPersistence(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+	list := New[int](values...)
+	clone := list.Clone()
+	slice := list.Slice(100, 900)
+	left, right := list.Split(500)
+	concatenated := left.Clone()
+	concatenated.Concat(right)
+
+	list.Set(600, -1)
+	list.RemoveRange(0, 300)
+	list.Insert(10, -2, -3)
+	list.Reverse()
+	left.Add(-4)
+	right.Insert(0, -5)
+
+	assertRope(t, clone, values)
+	assertRope(t, slice, values[100:900])
+	assertRope(t, concatenated, values)
+	assertRope(t, left, append(slices.Clone(values[:500]), -4))
+	assertRope(t, right, append([]int{-5}, values[500:]...))
+
+	left, right = concatenated.Split(-1)
+	assertRope(t, left, nil)
+	assertRope(t, right, values)
+	left, right = concatenated.Split(1001)
+	assertRope(t, left, values)
+	assertRope(t, right, nil)
+	concatenated.Concat(concatenated)
+	assertRope(t, concatenated, append(slices.Clone(values), values...))
+}
+
+func TestListIteratorAcrossLeaves(t *testing.T) {
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = i
+	}
+	list := New[int](values...)
+	it := list.Iterator()
+	for it.Next() {
+		if actualValue, expectedValue := it.Value(), it.Index(); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if it.Index()%3 == 0 {
+			it.Set(-it.Index())
+			if actualValue, expectedValue := it.Value(), -it.Index(); actualValue != expectedValue {
+				t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	for it.Prev() {
+		if it.Index()%3 == 0 {
+			if !it.Remove() {
+				t.Fatalf("Got %v expected %v", false, true)
+			}
+		}
+	}
+	expected := []int{}
+	for _, value := range values {
+		if value%3 != 0 {
+			expected = append(expected, value)
+		}
+	}
+	assertRope(t, list, expected)
+}
+
+func TestString(t *testing.T) {
+	text := NewString("Hello, 世界!")
+	if actualValue, expectedValue := text.Len(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := text.Get(7); actualValue != '世' || !ok {
+		t.Errorf("Got %v expected %v", actualValue, '世')
+	}
+	if _, ok := text.Get(10); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	text.Insert(7, "big ")
+	text.Insert(100, "ignored")
+	if actualValue, expectedValue := text.String(), "Hello, big 世界!"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	text.Delete(5, 11)
+	if actualValue, expectedValue := text.String(), "Hello世界!"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	left, right := text.Split(5)
+	if actualValue, expectedValue := left.String()+"|"+right.String(), "Hello|世界!"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	right.Concat(left)
+	if actualValue, expectedValue := right.String(), "世界!Hello"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := text.Slice(1, 7).String(), "ello世界"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := text.Slice(7, 1).String(), ""; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	runes := []rune{}
+	it := text.Iterator()
+	for it.Next() {
+		runes = append(runes, it.Value())
+	}
+	if actualValue, expectedValue := string(runes), text.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	text.Runes().Reverse()
+	if actualValue, expectedValue := text.String(), "!界世olleH"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	long := NewString(strings.Repeat("abc", 1000))
+	long.Insert(1500, "xyz")
+	if actualValue, expectedValue := long.String(), strings.Repeat("abc", 500)+"xyz"+strings.Repeat("abc", 500); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListConformance(t *testing.T) {
+	containertest.TestList(t, func() lists.List[int] { return New[int]() })
+}
+
+func benchmarkGet[T any](b *testing.B, list *List[T], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Get(n)
+		}
+	}
+}
+
+func benchmarkAdd(b *testing.B, list *List[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Add(n)
+		}
+	}
+}
+
+func benchmarkRemove[T any](b *testing.B, list *List[T], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Remove(n)
+		}
+	}
+}
+
+func BenchmarkRopeGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet[int](b, list, size)
+}
+
+func BenchmarkRopeGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, list, size)
+}
+
+func BenchmarkRopeAdd100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeAdd100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkAdd(b, list, size)
+}
+
+func BenchmarkRopeRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeRemove100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	benchmarkRemove(b, list, size)
+}
+
+func BenchmarkRopeInsertMiddle100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Add(n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		list.Insert(list.Size()/2, i)
+	}
+}
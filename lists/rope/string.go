@@ -0,0 +1,82 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rope
+
+import "strings"
+
+// String is a rope of runes for editing large texts, indexed by rune position
+type String struct {
+	runes *List[rune]
+}
+
+// NewString instantiates a new rope holding the runes of the text
+func NewString(text string) *String {
+	return &String{runes: New([]rune(text)...)}
+}
+
+// Len returns the number of runes of the text.
+func (s *String) Len() int {
+	return s.runes.Size()
+}
+
+// Get returns the rune at index in O(log n).
+// Second return parameter is true if index is within bounds of the text, otherwise false.
+func (s *String) Get(index int) (rune, bool) {
+	return s.runes.Get(index)
+}
+
+// Insert inserts the text at the rune position in O(log n + m), where m is the length of the inserted text.
+// Does not do anything if position is negative or bigger than the length of the text.
+func (s *String) Insert(index int, text string) {
+	s.runes.Insert(index, []rune(text)...)
+}
+
+// Delete removes the runes from position from (inclusive) to position to (exclusive) in O(log n).
+// Does not do anything if the range is not within bounds of the text.
+func (s *String) Delete(from, to int) {
+	s.runes.RemoveRange(from, to)
+}
+
+// Concat appends the other text in O(log n), the other rope is not modified.
+func (s *String) Concat(other *String) {
+	s.runes.Concat(other.runes)
+}
+
+// Split returns the text before the rune position and the text from the rune position on in O(log n).
+// The rope is not modified. The position is clamped to the bounds of the text.
+func (s *String) Split(index int) (*String, *String) {
+	left, right := s.runes.Split(index)
+	return &String{runes: left}, &String{runes: right}
+}
+
+// Slice returns the text from rune position from (inclusive) to position to (exclusive) in O(log n).
+// Returns an empty text if the range is not within bounds of the text.
+func (s *String) Slice(from, to int) *String {
+	return &String{runes: s.runes.Slice(from, to)}
+}
+
+// Iterator returns a stateful iterator over the runes of the text.
+func (s *String) Iterator() Iterator[rune] {
+	return s.runes.Iterator()
+}
+
+// Runes returns the underlying list of runes, modifying it modifies the text.
+func (s *String) Runes() *List[rune] {
+	return s.runes
+}
+
+// String returns the text.
+func (s *String) String() string {
+	var builder strings.Builder
+	builder.Grow(s.runes.Size())
+	for index := 0; index < s.runes.Size(); {
+		runes, _ := s.runes.root.leaf(index)
+		for _, r := range runes {
+			builder.WriteRune(r)
+		}
+		index += len(runes)
+	}
+	return builder.String()
+}
@@ -10,15 +10,19 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator holding the iterator's state
-type Iterator[T comparable] struct {
-	list    *List[T]
-	index   int
-	element *element[T]
+type Iterator[T any] struct {
+	list     *List[T]
+	index    int
+	element  *element[T]
+	previous *element[T] // element before the current one, needed for removal
+	version  int
+	removed  bool // current element was removed through the iterator
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the list is modified during iteration.
 func (list *List[T]) Iterator() Iterator[T] {
-	return Iterator[T]{list: list, index: -1, element: nil}
+	return Iterator[T]{list: list, index: -1, element: nil, version: list.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -26,6 +30,15 @@ func (list *List[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.list.version
+	}
+	iterator.checkVersion()
+	if iterator.removed {
+		// the iterator already holds the element that followed the removed one
+		iterator.removed = false
+		return iterator.list.withinRange(iterator.index)
+	}
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -34,8 +47,10 @@ func (iterator *Iterator[T]) Next() bool {
 		return false
 	}
 	if iterator.index == 0 {
+		iterator.previous = nil
 		iterator.element = iterator.list.first
 	} else {
+		iterator.previous = iterator.element
 		iterator.element = iterator.element.next
 	}
 	return true
@@ -44,6 +59,7 @@ func (iterator *Iterator[T]) Next() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
 	return iterator.element.value
 }
 
@@ -58,6 +74,9 @@ func (iterator *Iterator[T]) Index() int {
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
 	iterator.element = nil
+	iterator.previous = nil
+	iterator.version = iterator.list.version
+	iterator.removed = false
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -81,3 +100,38 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the list and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one, Value() is undefined until then.
+// Removing through the iterator is the only modification of the list that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	next := iterator.element.next
+	iterator.list.unlink(iterator.previous, iterator.element)
+	iterator.element = next
+	iterator.version = iterator.list.version
+	iterator.removed = true
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.list.withinRange(iterator.index) {
+		return false
+	}
+	iterator.element.value = value
+	return true
+}
+
+// checkVersion panics if the list was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.list.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ugurcsen/gods-generic/internal/arena"
 	"github.com/ugurcsen/gods-generic/lists"
 	"github.com/ugurcsen/gods-generic/utils"
 )
@@ -21,20 +22,45 @@ import (
 var _ lists.List[int] = (*List[int])(nil)
 
 // List holds the elements, where each element points to the next element
-type List[T comparable] struct {
-	first *element[T]
-	last  *element[T]
-	size  int
+type List[T any] struct {
+	first    *element[T]
+	last     *element[T]
+	size     int
+	equality utils.Equality[T]
+	version  int                      // incremented on every modification, used by iterators to detect concurrent modification
+	elements *arena.Arena[element[T]] // allocates the elements if set, otherwise they are allocated one by one
 }
 
-type element[T comparable] struct {
+type element[T any] struct {
 	value T
 	next  *element[T]
 }
 
 // New instantiates a new list and adds the passed values, if any, to the list
-func New[T comparable](values ...T) *List[T] {
-	list := &List[T]{}
+func New[T any](values ...T) *List[T] {
+	list := &List[T]{equality: utils.DefaultEquality[T]()}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// NewWithEquality instantiates a new list that compares values with the given equality
+// (used by Contains, IndexOf and Equal) and adds the passed values, if any, to the list
+func NewWithEquality[T any](equality utils.Equality[T], values ...T) *List[T] {
+	list := &List[T]{equality: equality}
+	if len(values) > 0 {
+		list.Add(values...)
+	}
+	return list
+}
+
+// NewWithArena instantiates a new list whose elements are allocated chunkSize at a time and reused after removal,
+// and adds the passed values, if any, to the list. This reduces the number of heap objects, and thus the garbage
+// collection work, of large lists. A chunk is only reclaimed once all of its elements are removed.
+// A chunk size of one only reuses removed elements.
+func NewWithArena[T any](chunkSize int, values ...T) *List[T] {
+	list := &List[T]{elements: arena.New[element[T]](chunkSize), equality: utils.DefaultEquality[T]()}
 	if len(values) > 0 {
 		list.Add(values...)
 	}
@@ -43,8 +69,12 @@ func New[T comparable](values ...T) *List[T] {
 
 // Add appends a value (one or more) at the end of the list (same as Append())
 func (list *List[T]) Add(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	for _, value := range values {
-		newElement := &element[T]{value: value}
+		newElement := list.newElement(value)
 		if list.size == 0 {
 			list.first = newElement
 			list.last = newElement
@@ -64,8 +94,13 @@ func (list *List[T]) Append(values ...T) {
 // Prepend prepends a values (or more)
 func (list *List[T]) Prepend(values ...T) {
 	// in reverse to keep passed order i.e. ["c","d"] -> Prepend(["a","b"]) -> ["a","b","c",d"]
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	for v := len(values) - 1; v >= 0; v-- {
-		newElement := &element[T]{value: values[v], next: list.first}
+		newElement := list.newElement(values[v])
+		newElement.next = list.first
 		list.first = newElement
 		if list.size == 0 {
 			list.last = newElement
@@ -74,6 +109,11 @@ func (list *List[T]) Prepend(values ...T) {
 	}
 }
 
+// AddAll appends all elements of the other list at the end of the list.
+func (list *List[T]) AddAll(other lists.List[T]) {
+	list.Add(other.Values()...)
+}
+
 // Get returns the element at index.
 // Second return parameter is true if index is within bounds of the array and array is not empty, otherwise false.
 func (list *List[T]) Get(index int) (T, bool) {
@@ -96,11 +136,7 @@ func (list *List[T]) Remove(index int) {
 	if !list.withinRange(index) {
 		return
 	}
-
-	if list.size == 1 {
-		list.Clear()
-		return
-	}
+	list.version++
 
 	var beforeElement *element[T]
 	element := list.first
@@ -118,38 +154,94 @@ func (list *List[T]) Remove(index int) {
 		beforeElement.next = element.next
 	}
 
-	element = nil
+	list.freeElement(element)
 
 	list.size--
 }
 
+// RemoveRange removes the elements from index from (inclusive) to index to (exclusive).
+// Does not do anything if the range is not within bounds of the list.
+func (list *List[T]) RemoveRange(from, to int) {
+	if from < 0 || to > list.size || from >= to {
+		return
+	}
+	list.version++
+	var beforeElement *element[T]
+	element := list.first
+	for e := 0; e != from; e, element = e+1, element.next {
+		beforeElement = element
+	}
+	for e := from; e != to; e++ {
+		next := element.next
+		list.freeElement(element)
+		element = next
+	}
+	// element is now the first element after the range, if any
+	if beforeElement == nil {
+		list.first = element
+	} else {
+		beforeElement.next = element
+	}
+	if element == nil {
+		list.last = beforeElement
+	}
+	list.size -= to - from
+}
+
+// RemoveIf removes all elements satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RemoveIf(f func(value T) bool) int {
+	removed := 0
+	var beforeElement, next *element[T]
+	for element := list.first; element != nil; element = next {
+		next = element.next
+		if f(element.value) {
+			list.freeElement(element)
+			removed++
+			continue
+		}
+		if beforeElement == nil {
+			list.first = element
+		} else {
+			beforeElement.next = element
+		}
+		beforeElement = element
+	}
+	if beforeElement == nil {
+		list.first = nil
+	} else {
+		beforeElement.next = nil
+	}
+	list.last = beforeElement
+	list.size -= removed
+	if removed > 0 {
+		list.version++
+	}
+	return removed
+}
+
+// RetainIf removes all elements not satisfying the given function and returns the number of removed elements.
+func (list *List[T]) RetainIf(f func(value T) bool) int {
+	return list.RemoveIf(func(value T) bool { return !f(value) })
+}
+
 // Contains checks if values (one or more) are present in the set.
 // All values have to be present in the set for the method to return true.
 // Performance time complexity of n^2.
 // Returns true if no arguments are passed at all, i.e. set is always super-set of empty set.
 func (list *List[T]) Contains(values ...T) bool {
-
-	if len(values) == 0 {
-		return true
-	}
-	if list.size == 0 {
-		return false
-	}
 	for _, value := range values {
-		found := false
-		for element := list.first; element != nil; element = element.next {
-			if element.value == value {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if list.IndexOf(value) < 0 {
 			return false
 		}
 	}
 	return true
 }
 
+// ContainsFunc checks if any element of the list satisfies the given function.
+func (list *List[T]) ContainsFunc(f func(value T) bool) bool {
+	return list.IndexOfFunc(f) >= 0
+}
+
 // Values returns all elements in the list.
 func (list *List[T]) Values() []T {
 	values := make([]T, list.size, list.size)
@@ -159,19 +251,45 @@ func (list *List[T]) Values() []T {
 	return values
 }
 
-// IndexOf returns index of provided element
+// IndexOf returns index of provided element, or -1 if the list does not contain it
 func (list *List[T]) IndexOf(value T) int {
-	if list.size == 0 {
-		return -1
-	}
-	for index, element := range list.Values() {
-		if element == value {
+	return list.IndexOfFunc(func(element T) bool { return list.equal(element, value) })
+}
+
+// IndexOfFunc returns index of the first element satisfying the given function, or -1 if there is none
+func (list *List[T]) IndexOfFunc(f func(value T) bool) int {
+	for index, element := 0, list.first; element != nil; index, element = index+1, element.next {
+		if f(element.value) {
 			return index
 		}
 	}
 	return -1
 }
 
+// LastIndexOf returns index of the last occurrence of provided element, or -1 if the list does not contain it
+func (list *List[T]) LastIndexOf(value T) int {
+	lastIndex := -1
+	for index, element := 0, list.first; element != nil; index, element = index+1, element.next {
+		if list.equal(element.value, value) {
+			lastIndex = index
+		}
+	}
+	return lastIndex
+}
+
+// BinarySearch searches for the value in the list sorted in ascending order with respect to the given comparator.
+// Returns the index of the first element equal to the value and true if found,
+// otherwise the index where the value would be inserted to keep the list sorted and false.
+// Elements cannot be accessed at random, so the list is scanned up to that index.
+func (list *List[T]) BinarySearch(value T, comparator utils.Comparator[T]) (int, bool) {
+	for index, element := 0, list.first; element != nil; index, element = index+1, element.next {
+		if result := comparator(element.value, value); result >= 0 {
+			return index, result == 0
+		}
+	}
+	return list.size, false
+}
+
 // Empty returns true if list does not contain any elements.
 func (list *List[T]) Empty() bool {
 	return list.size == 0
@@ -184,30 +302,38 @@ func (list *List[T]) Size() int {
 
 // Clear removes all elements from the list.
 func (list *List[T]) Clear() {
+	list.version++
 	list.size = 0
 	list.first = nil
 	list.last = nil
+	if list.elements != nil {
+		list.elements.Reset()
+	}
 }
 
-// Sort sort values (in-place) using.
+// Sort sorts values (in-place) using merge sort, relinking the elements instead of copying values.
+// Merge sort is stable, i.e. equal values keep their order.
 func (list *List[T]) Sort(comparator utils.Comparator[T]) {
-
 	if list.size < 2 {
 		return
 	}
+	list.version++
+	list.first = mergeSort(list.first, list.size, comparator)
+	list.last = list.first
+	for list.last.next != nil {
+		list.last = list.last.next
+	}
+}
 
-	values := list.Values()
-	utils.Sort(values, comparator)
-
-	list.Clear()
-
-	list.Add(values...)
-
+// SortStable sorts values (in-place) keeping the order of equal values, same as Sort.
+func (list *List[T]) SortStable(comparator utils.Comparator[T]) {
+	list.Sort(comparator)
 }
 
 // Swap swaps values of two elements at the given indices.
 func (list *List[T]) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) && i != j {
+		list.version++
 		var element1, element2 *element[T]
 		for e, currentElement := 0, list.first; element1 == nil || element2 == nil; e, currentElement = e+1, currentElement.next {
 			switch e {
@@ -221,6 +347,58 @@ func (list *List[T]) Swap(i, j int) {
 	}
 }
 
+// SubList returns a new list holding the elements from index from (inclusive) to index to (exclusive).
+// Returns an empty list if the range is not within bounds of the list.
+func (list *List[T]) SubList(from, to int) *List[T] {
+	newList := &List[T]{equality: list.equality}
+	if from < 0 || to > list.size || from >= to {
+		return newList
+	}
+	element := list.first
+	for e := 0; e != from; e, element = e+1, element.next {
+	}
+	for e := from; e != to; e, element = e+1, element.next {
+		newList.Add(element.value)
+	}
+	return newList
+}
+
+// Reverse reverses the order of the elements (in-place), relinking the elements instead of copying values.
+func (list *List[T]) Reverse() {
+	if list.size < 2 {
+		return
+	}
+	list.version++
+	var previous *element[T]
+	list.last = list.first
+	for element := list.first; element != nil; {
+		next := element.next
+		element.next = previous
+		previous, element = element, next
+	}
+	list.first = previous
+}
+
+// Rotate rotates the elements by k positions to the right (in-place), i.e. the last k elements are moved to the
+// front of the list. Negative k rotates to the left.
+func (list *List[T]) Rotate(k int) {
+	if list.size < 2 {
+		return
+	}
+	k = (k%list.size + list.size) % list.size
+	if k == 0 {
+		return
+	}
+	list.version++
+	newLast := list.first
+	for e := 1; e != list.size-k; e, newLast = e+1, newLast.next {
+	}
+	list.last.next = list.first
+	list.first = newLast.next
+	list.last = newLast
+	list.last.next = nil
+}
+
 // Insert inserts values at specified index position shifting the value at that position (if any) and any subsequent elements to the right.
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
@@ -234,9 +412,14 @@ func (list *List[T]) Insert(index int, values ...T) {
 		return
 	}
 
+	if len(values) == 0 {
+		return
+	}
+	list.version++
 	list.size += len(values)
 
 	var beforeElement *element[T]
+
 	foundElement := list.first
 	for e := 0; e != index; e, foundElement = e+1, foundElement.next {
 		beforeElement = foundElement
@@ -245,7 +428,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	if foundElement == list.first {
 		oldNextElement := list.first
 		for i, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := list.newElement(value)
 			if i == 0 {
 				list.first = newElement
 			} else {
@@ -257,7 +440,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 	} else {
 		oldNextElement := beforeElement.next
 		for _, value := range values {
-			newElement := &element[T]{value: value}
+			newElement := list.newElement(value)
 			beforeElement.next = newElement
 			beforeElement = newElement
 		}
@@ -265,6 +448,14 @@ func (list *List[T]) Insert(index int, values ...T) {
 	}
 }
 
+// InsertAll inserts all elements of the other list at specified index position shifting the value at that position
+// (if any) and any subsequent elements to the right.
+// Does not do anything if position is negative or bigger than list's size
+// Note: position equal to list's size is valid, i.e. append.
+func (list *List[T]) InsertAll(index int, other lists.List[T]) {
+	list.Insert(index, other.Values()...)
+}
+
 // Set value at specified index
 // Does not do anything if position is negative or bigger than list's size
 // Note: position equal to list's size is valid, i.e. append.
@@ -300,3 +491,80 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// Removes the element from the list, before is the element preceding it or nil if it is the first element
+func (list *List[T]) unlink(before, element *element[T]) {
+	if before == nil {
+		list.first = element.next
+	} else {
+		before.next = element.next
+	}
+	if element == list.last {
+		list.last = before
+	}
+	list.freeElement(element)
+	list.size--
+	list.version++
+}
+
+// Allocates an unlinked element holding the value, from the arena if the list has one
+func (list *List[T]) newElement(value T) *element[T] {
+	if list.elements == nil {
+		return &element[T]{value: value}
+	}
+	newElement := list.elements.Alloc()
+	newElement.value = value
+	return newElement
+}
+
+// Returns the removed element to the arena if the list has one, otherwise only unlinks it from its successor
+func (list *List[T]) freeElement(element *element[T]) {
+	if list.elements == nil {
+		element.next = nil
+		return
+	}
+	list.elements.Free(element)
+}
+
+// Compare two values with the list's equality, utils.DefaultEquality is used if none was given
+func (list *List[T]) equal(a, b T) bool {
+	if list.equality == nil {
+		return utils.DefaultEquality[T]()(a, b)
+	}
+	return list.equality(a, b)
+}
+
+// Sorts the chain of size elements starting at first and returns the new first element.
+// Only the next references are relinked.
+func mergeSort[T any](first *element[T], size int, comparator utils.Comparator[T]) *element[T] {
+	if size < 2 {
+		return first
+	}
+	middle := first
+	for i := 1; i < size/2; i++ {
+		middle = middle.next
+	}
+	second := middle.next
+	middle.next = nil
+	return merge(mergeSort(first, size/2, comparator), mergeSort(second, size-size/2, comparator), comparator)
+}
+
+// Merges two sorted chains, taking from the first chain on ties
+func merge[T any](first, second *element[T], comparator utils.Comparator[T]) *element[T] {
+	head := &element[T]{}
+	tail := head
+	for first != nil && second != nil {
+		if comparator(second.value, first.value) < 0 {
+			tail.next, second = second, second.next
+		} else {
+			tail.next, first = first, first.next
+		}
+		tail = tail.next
+	}
+	if first != nil {
+		tail.next = first
+	} else {
+		tail.next = second
+	}
+	return head.next
+}
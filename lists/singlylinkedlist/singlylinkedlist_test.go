@@ -10,6 +10,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/lists"
 	"github.com/ugurcsen/gods-generic/utils"
 )
 
@@ -542,7 +545,424 @@ func TestListString(t *testing.T) {
 	}
 }
 
-func benchmarkGet[T comparable](b *testing.B, list *List[T], size int) {
+func TestListClone(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	clone := list.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(clone.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Set(0, "x")
+	clone.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(list.Values())...), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := list.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(deepClone.Values())...), "ABC"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	empty := New[string]().Clone()
+	if actualValue := empty.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	list := New[string]("a", "b", "c")
+	if actualValue := list.Equal(list.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "b", "c")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := list.Equal(New[string]("a", "c", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := list.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[string]().Equal(New[string]()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestListIndexOfFunc(t *testing.T) {
+	list := New[int](1, 2, 3, 4)
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value%2 == 0 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.IndexOfFunc(func(value int) bool { return value > 4 }), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 3 }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsFunc(func(value int) bool { return value > 4 }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNonComparable(t *testing.T) {
+	list := New[[]int]([]int{1, 2}, []int{3})
+	list.Add(nil)
+	if actualValue, expectedValue := list.IndexOf([]int{3}), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1, 2}, nil), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains([]int{1}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(list.Clone()), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNewWithEquality(t *testing.T) {
+	sameLength := func(a, b string) bool { return len(a) == len(b) }
+	list := NewWithEquality(sameLength, "a", "bb", "ccc")
+	if actualValue, expectedValue := list.IndexOf("xx"), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Contains("x", "yyy"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	selected := list.Select(func(index int, value string) bool { return index > 0 })
+	if actualValue, expectedValue := selected.Contains("zz"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Equal(NewWithEquality(sameLength, "x", "yy", "zzz")), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSortStable(t *testing.T) {
+	list := New[string]("b2", "a1", "b1", "c1", "a2", "a3")
+	list.SortStable(func(a, b string) int { return utils.ByteComparator(a[0], b[0]) })
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d1")
+	if actualValue, expectedValue := fmt.Sprintf("%v", list.Values()), "[a1 a2 a3 b2 b1 c1 d1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func assertListValues(t *testing.T, list *List[int], expected string) {
+	t.Helper()
+	if actualValue := fmt.Sprintf("%v", list.Values()); actualValue != expected {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+	if actualValue, expectedValue := list.Size(), len(strings.Fields(strings.Trim(expected, "[]"))); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if list.size == 0 {
+		if list.first != nil || list.last != nil {
+			t.Errorf("Got %v %v expected %v %v", list.first, list.last, nil, nil)
+		}
+		return
+	}
+	if list.last.next != nil {
+		t.Errorf("Got %v expected %v", list.last.next, nil)
+	}
+	last := list.first
+	for last.next != nil {
+		last = last.next
+	}
+	if last != list.last {
+		t.Errorf("Got %v expected %v", list.last.value, last.value)
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6)
+	list.RemoveRange(-1, 2)
+	list.RemoveRange(2, 8)
+	list.RemoveRange(3, 3)
+	assertListValues(t, list, "[0 1 2 3 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[0 1 4 5 6]")
+	list.RemoveRange(0, 1)
+	assertListValues(t, list, "[1 4 5 6]")
+	list.RemoveRange(2, 4)
+	assertListValues(t, list, "[1 4]")
+	list.RemoveRange(0, 2)
+	assertListValues(t, list, "[]")
+	list.Add(7, 8)
+	assertListValues(t, list, "[7 8]")
+}
+
+func TestListRemoveIf(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4, 5, 6, 7)
+	isEven := func(value int) bool { return value%2 == 0 }
+	if actualValue, expectedValue := list.RemoveIf(isEven), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[1 3 5 7]")
+	if actualValue, expectedValue := list.RetainIf(func(value int) bool { return value > 2 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[3 5 7]")
+	if actualValue, expectedValue := list.RemoveIf(isEven), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RetainIf(isEven), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	assertListValues(t, list, "[1]")
+}
+
+func TestListAddAllInsertAll(t *testing.T) {
+	list := New[int](1, 2)
+	list.AddAll(New[int](3, 4))
+	assertListValues(t, list, "[1 2 3 4]")
+	list.InsertAll(0, New[int](-1, 0))
+	assertListValues(t, list, "[-1 0 1 2 3 4]")
+	list.InsertAll(3, list)
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4]")
+	list.InsertAll(list.Size(), New[int](5))
+	list.InsertAll(list.Size()+1, New[int](6))
+	list.AddAll(New[int]())
+	assertListValues(t, list, "[-1 0 1 -1 0 1 2 3 4 2 3 4 5]")
+}
+
+func TestListSubList(t *testing.T) {
+	list := New[int](0, 1, 2, 3, 4)
+	assertListValues(t, list.SubList(1, 3), "[1 2]")
+	assertListValues(t, list.SubList(0, 5), "[0 1 2 3 4]")
+	assertListValues(t, list.SubList(3, 3), "[]")
+	assertListValues(t, list.SubList(-1, 3), "[]")
+	assertListValues(t, list.SubList(2, 6), "[]")
+	subList := list.SubList(3, 5)
+	subList.Add(5)
+	assertListValues(t, subList, "[3 4 5]")
+	assertListValues(t, list, "[0 1 2 3 4]")
+}
+
+func TestListReverse(t *testing.T) {
+	list := New[int]()
+	list.Reverse()
+	assertListValues(t, list, "[]")
+	list.Add(1)
+	list.Reverse()
+	assertListValues(t, list, "[1]")
+	list.Add(2, 3, 4)
+	list.Reverse()
+	assertListValues(t, list, "[4 3 2 1]")
+	list.Add(0)
+	assertListValues(t, list, "[4 3 2 1 0]")
+}
+
+func TestListRotate(t *testing.T) {
+	list := New[int]()
+	list.Rotate(3)
+	assertListValues(t, list, "[]")
+	list.Add(0, 1, 2, 3, 4)
+	tests := [][]interface{}{
+		{0, "[0 1 2 3 4]"},
+		{1, "[4 0 1 2 3]"},
+		{2, "[2 3 4 0 1]"},
+		{4, "[3 4 0 1 2]"},
+		{-1, "[4 0 1 2 3]"},
+		{-3, "[2 3 4 0 1]"},
+		{12, "[0 1 2 3 4]"},
+		{-7, "[2 3 4 0 1]"},
+		{5, "[2 3 4 0 1]"},
+	}
+	for _, test := range tests {
+		list.Rotate(test[0].(int))
+		assertListValues(t, list, test[1].(string))
+	}
+	list.Add(5)
+	assertListValues(t, list, "[2 3 4 0 1 5]")
+}
+
+func TestListLastIndexOf(t *testing.T) {
+	list := New[int](1, 2, 1, 3, 2)
+	if actualValue, expectedValue := list.LastIndexOf(1), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(2), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf(4), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListBinarySearch(t *testing.T) {
+	list := New[int](1, 3, 3, 5)
+	tests := [][]interface{}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{5, 3, true},
+		{6, 4, false},
+	}
+	for _, test := range tests {
+		index, found := list.BinarySearch(test[0].(int), utils.NumberComparator[int])
+		if index != test[1].(int) || found != test[2].(bool) {
+			t.Errorf("Got %v %v expected %v %v", index, found, test[1], test[2])
+		}
+	}
+	if index, found := New[int]().BinarySearch(1, utils.NumberComparator[int]); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	list := New[string]("a", "b", "c")
+	it := list.Iterator()
+	it.Next()
+	list.Set(0, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add("d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	list.Remove(0) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), "b"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Rotate(1)
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestListIteratorRemove(t *testing.T) {
+	list := New[string]("a", "b", "c", "d", "e")
+	it := list.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []string
+	for it.Next() {
+		value := it.Value()
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), value))
+		if value == "a" || value == "b" || value == "d" {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		} else if actualValue, expectedValue := it.Set(value+value), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[0:a 0:b 0:c 1:d 1:e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[cc ee]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Set("x"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Add("f")
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[cc ee f]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.First()
+	it.Remove()
+	it.Next()
+	it.Next()
+	it.Remove()
+	list.Add("g", "h")
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[ee g h]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSinglyLinkedListArena(t *testing.T) {
+	list, expected := NewWithArena[int](8), New[int]()
+	for i := 0; i < 1000; i++ {
+		switch i % 5 {
+		case 0, 1:
+			list.Add(i)
+			expected.Add(i)
+		case 2:
+			list.Prepend(i)
+			expected.Prepend(i)
+		case 3:
+			list.Remove(list.Size() / 2)
+			expected.Remove(expected.Size() / 2)
+		case 4:
+			list.Insert(list.Size()/3, i, i+1)
+			expected.Insert(expected.Size()/3, i, i+1)
+		}
+	}
+	list.RemoveIf(func(value int) bool { return value%7 == 0 })
+	expected.RemoveIf(func(value int) bool { return value%7 == 0 })
+	list.RemoveRange(10, 20)
+	expected.RemoveRange(10, 20)
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), fmt.Sprint(expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone := list.Clone()
+	list.Clear()
+	if actualValue, expectedValue := fmt.Sprint(clone.Values()), fmt.Sprint(expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removed elements are reused, so that refilling the list does not allocate
+	list.Add(1, 2, 3)
+	allocs := testing.AllocsPerRun(10, func() {
+		list.Remove(0)
+		list.Remove(0)
+		list.Remove(0)
+		list.Add(1)
+		list.Add(2)
+		list.Add(3)
+	})
+	if actualValue, expectedValue := allocs, 0.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListConformance(t *testing.T) {
+	t.Run("New", func(t *testing.T) {
+		containertest.TestList(t, func() lists.List[int] { return New[int]() })
+	})
+	t.Run("Arena", func(t *testing.T) {
+		containertest.TestList(t, func() lists.List[int] { return NewWithArena[int](2) })
+	})
+}
+
+// constructors of the lists under benchmark, allocating the elements one by one and from an arena
+var benchmarkLists = []struct {
+	name string
+	new  func() *List[int]
+}{
+	{"New", func() *List[int] { return New[int]() }},
+	{"Arena", func() *List[int] { return NewWithArena[int](1024) }},
+}
+
+func benchmarkGet[T any](b *testing.B, list *List[T], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
 			list.Get(n)
@@ -567,130 +987,190 @@ func benchmarkRemove(b *testing.B, list *List[int], size int) {
 }
 
 func BenchmarkSinglyLinkedListGet100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListGet1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListGet10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListGet100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkGet(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListAdd100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
+	}
 }
 
 func BenchmarkSinglyLinkedListAdd1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListAdd10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListAdd100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkAdd(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkAdd(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListRemove100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListRemove1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListRemove10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
 
 func BenchmarkSinglyLinkedListRemove100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	list := New[int]()
-	for n := 0; n < size; n++ {
-		list.Add(n)
+	for _, newList := range benchmarkLists {
+		b.Run(newList.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			list := newList.new()
+			for n := 0; n < size; n++ {
+				list.Add(n)
+			}
+			b.StartTimer()
+			benchmarkRemove(b, list, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, list, size)
 }
@@ -0,0 +1,51 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package customhashmap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+	"slices"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[string, int], int] = (*Map[string, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+// The table is copied directly without rehashing any key.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return &Map[K, T]{entries: slices.Clone(m.entries), size: m.size, shift: m.shift, hasher: m.hasher}
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	clone := m.Clone()
+	for index := range clone.entries {
+		if clone.entries[index].used {
+			clone.entries[index].value = copyValue(clone.entries[index].value)
+		}
+	}
+	return clone
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+// Keys are compared with the hasher of the map, values with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for _, entry := range m.entries {
+		if !entry.used {
+			continue
+		}
+		otherValue, found := other.Get(entry.key)
+		if !found || !equal(entry.value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
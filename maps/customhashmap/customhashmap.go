@@ -0,0 +1,187 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package customhashmap implements a map backed by an open-addressing hash table
+// that hashes and compares keys with a custom hasher.
+//
+// Unlike the hashmap, keys do not need to be comparable, e.g. byte slices or strings
+// compared without regard to case can be used as keys given a suitable utils.Hasher.
+//
+// Collisions are resolved with linear probing and removals shift the following entries back,
+// so the table never holds tombstones.
+//
+// Elements are unordered in the map.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Open_addressing
+package customhashmap
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/utils"
+	"math/bits"
+	"strings"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Map[string, int])(nil)
+
+// Map holds the elements in an open-addressing hash table
+type Map[K any, T any] struct {
+	entries []entry[K, T]
+	size    int
+	shift   uint // 64 - log2(len(entries)), used to map hashes to slots
+	hasher  utils.Hasher[K]
+}
+
+type entry[K any, T any] struct {
+	key   K
+	value T
+	hash  uint64
+	used  bool
+}
+
+const (
+	minCapacity = 8
+	maxLoad     = 0.75 // grow when the table is 75% full
+)
+
+// New instantiates a hash map that hashes and compares keys with the given hasher.
+func New[K any, T any](hasher utils.Hasher[K]) *Map[K, T] {
+	m := &Map[K, T]{hasher: hasher}
+	m.resize(minCapacity)
+	return m
+}
+
+// Put inserts element into the map.
+func (m *Map[K, T]) Put(key K, value T) {
+	hash := m.hasher.Hash(key)
+	index, found := m.find(key, hash)
+	if found {
+		m.entries[index].value = value
+		return
+	}
+	if float64(m.size+1) > maxLoad*float64(len(m.entries)) {
+		m.resize(2 * len(m.entries))
+		index, _ = m.find(key, hash)
+	}
+	m.entries[index] = entry[K, T]{key: key, value: value, hash: hash, used: true}
+	m.size++
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, T]) Get(key K) (value T, found bool) {
+	if index, found := m.find(key, m.hasher.Hash(key)); found {
+		return m.entries[index].value, true
+	}
+	return value, false
+}
+
+// Remove removes the element from the map by key.
+func (m *Map[K, T]) Remove(key K) {
+	index, found := m.find(key, m.hasher.Hash(key))
+	if !found {
+		return
+	}
+	// shift back the following entries of the probe sequence that may take the freed slot
+	mask := len(m.entries) - 1
+	for next := (index + 1) & mask; m.entries[next].used; next = (next + 1) & mask {
+		if (next-m.slot(m.entries[next].hash))&mask >= (next-index)&mask {
+			m.entries[index] = m.entries[next]
+			index = next
+		}
+	}
+	m.entries[index] = entry[K, T]{} // cleanup references
+	m.size--
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, T]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, T]) Size() int {
+	return m.size
+}
+
+// Keys returns all keys (random order).
+func (m *Map[K, T]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	for _, entry := range m.entries {
+		if entry.used {
+			keys = append(keys, entry.key)
+		}
+	}
+	return keys
+}
+
+// Values returns all values (random order).
+func (m *Map[K, T]) Values() []T {
+	values := make([]T, 0, m.size)
+	for _, entry := range m.entries {
+		if entry.used {
+			values = append(values, entry.value)
+		}
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, T]) Clear() {
+	m.size = 0
+	m.entries = nil
+	m.resize(minCapacity)
+}
+
+// String returns a string representation of container
+func (m *Map[K, T]) String() string {
+	str := "CustomHashMap\nmap["
+	for _, entry := range m.entries {
+		if entry.used {
+			str += fmt.Sprintf("%v:%v ", entry.key, entry.value)
+		}
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// find returns the slot holding the key and true if the key is in the map,
+// otherwise the empty slot where the key would be inserted and false.
+func (m *Map[K, T]) find(key K, hash uint64) (int, bool) {
+	mask := len(m.entries) - 1
+	index := m.slot(hash)
+	for m.entries[index].used {
+		if m.entries[index].hash == hash && m.hasher.Equal(m.entries[index].key, key) {
+			return index, true
+		}
+		index = (index + 1) & mask
+	}
+	return index, false
+}
+
+// slot returns the preferred slot of the hash, multiplicative hashing spreads weak hashes over the table.
+func (m *Map[K, T]) slot(hash uint64) int {
+	return int((hash * 0x9e3779b97f4a7c15) >> m.shift)
+}
+
+// resize reinserts all entries into a new table of the given capacity, which must be a power of two.
+func (m *Map[K, T]) resize(capacity int) {
+	entries := m.entries
+	m.entries = make([]entry[K, T], capacity)
+	m.shift = uint(64 - bits.TrailingZeros(uint(capacity)))
+	mask := capacity - 1
+	for _, entry := range entries {
+		if !entry.used {
+			continue
+		}
+		index := m.slot(entry.hash)
+		for m.entries[index].used {
+			index = (index + 1) & mask
+		}
+		m.entries[index] = entry
+	}
+}
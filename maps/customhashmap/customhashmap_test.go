@@ -0,0 +1,446 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package customhashmap
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string](intHasher)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := m.Keys(), []interface{}{1, 2, 3, 4, 5, 6, 7}; !sameElements(utils.GenericToInterfaceSlice(actualValue), expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []interface{}{"a", "b", "c", "d", "e", "f", "g"}; !sameElements(utils.GenericToInterfaceSlice(actualValue), expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "", false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New[int, string](intHasher)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(7)
+	m.Remove(8)
+	m.Remove(5)
+
+	if actualValue, expectedValue := m.Keys(), []interface{}{1, 2, 3, 4}; !sameElements(utils.GenericToInterfaceSlice(actualValue), expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := m.Values(), []interface{}{"a", "b", "c", "d"}; !sameElements(utils.GenericToInterfaceSlice(actualValue), expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+
+	tests2 := [][]interface{}{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "", false},
+		{6, "", false},
+		{7, "", false},
+		{8, "", false},
+	}
+
+	for _, test := range tests2 {
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+
+	m.Remove(1)
+	m.Remove(4)
+	m.Remove(2)
+	m.Remove(3)
+	m.Remove(2)
+	m.Remove(2)
+
+	if actualValue, expectedValue := fmt.Sprintf("%d", m.Keys()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%s", m.Values()), "[]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+// intHasher hashes ints to themselves, the map has to spread such weak hashes over the table
+var intHasher = utils.NewHasher(func(key int) uint64 { return uint64(key) }, func(a, b int) bool { return a == b })
+
+func TestMapBytesKeys(t *testing.T) {
+	m := New[[]byte, int](utils.BytesHasher{})
+	m.Put([]byte("a"), 1)
+	m.Put([]byte("b"), 2)
+	m.Put([]byte("a"), 3) // same contents, overwrite
+	m.Put(nil, 4)
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get([]byte("a")); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, found := m.Get([]byte{}); actualValue != 4 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	m.Remove([]byte("b"))
+	if _, found := m.Get([]byte("b")); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapCaseInsensitiveKeys(t *testing.T) {
+	m := New[string, int](utils.CaseInsensitiveStringHasher{})
+	m.Put("Content-Type", 1)
+	m.Put("content-type", 2)
+	m.Put("ÄPFEL", 3)
+	if actualValue, expectedValue := m.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// the key first put is kept
+	if actualValue, expectedValue := m.Keys(), []interface{}{"Content-Type", "ÄPFEL"}; !sameElements(utils.GenericToInterfaceSlice(actualValue), expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get("CONTENT-TYPE"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := m.Get("äpfel"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMapCollisions(t *testing.T) {
+	// all keys of a residue share a hash, so probe sequences are long and wrap around the table
+	m := New[int, int](utils.NewHasher(func(key int) uint64 { return uint64(key % 3) }, func(a, b int) bool { return a == b }))
+	expected := make(map[int]int)
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(200)
+		if random.Intn(3) == 0 {
+			m.Remove(key)
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+	}
+	if actualValue, expectedValue := m.Size(), len(expected); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for key := 0; key < 200; key++ {
+		actualValue, actualFound := m.Get(key)
+		expectedValue, expectedFound := expected[key]
+		if actualValue != expectedValue || actualFound != expectedFound {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, actualFound, expectedValue, expectedFound)
+		}
+	}
+	for key := range expected {
+		m.Remove(key)
+	}
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	c := New[string, int](utils.CaseInsensitiveStringHasher{})
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "CustomHashMap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func sameElements(a []interface{}, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, av := range a {
+		found := false
+		for _, bv := range b {
+			if av == bv {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMapClone(t *testing.T) {
+	m := New[int, []int](intHasher)
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	m.Put(3, []int{3})
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, []int{4})
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	deepClone := m.DeepClone(func(value []int) []int { return append([]int(nil), value...) })
+	if actualValue := deepClone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	value, _ := deepClone.Get(2)
+	value[0] = 20
+	if actualValue, _ := m.Get(2); actualValue[0] != 2 {
+		t.Errorf("Got %v expected %v", actualValue[0], 2)
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	shallowClone := m.Clone()
+	value, _ = shallowClone.Get(3)
+	value[0] = 30
+	if actualValue, _ := m.Get(3); actualValue[0] != 30 {
+		t.Errorf("Got %v expected %v", actualValue[0], 30)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := New[int, []int](intHasher)
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	other := New[int, []int](intHasher)
+	other.Put(2, []int{2})
+	other.Put(1, []int{1})
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(3, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Remove(3)
+	other.Put(2, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return New[int, string](intHasher) })
+}
+
+func benchmarkGet(b *testing.B, m *Map[int, struct{}], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, m *Map[int, struct{}], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRemove(b *testing.B, m *Map[int, struct{}], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Remove(n)
+		}
+	}
+}
+
+func BenchmarkCustomHashMapGet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkCustomHashMapGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkCustomHashMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkCustomHashMapGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, m, size)
+}
+
+func BenchmarkCustomHashMapPut100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, struct{}](intHasher)
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkCustomHashMapPut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkCustomHashMapPut10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkCustomHashMapPut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkPut(b, m, size)
+}
+
+func BenchmarkCustomHashMapRemove100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkCustomHashMapRemove1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkCustomHashMapRemove10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
+
+func BenchmarkCustomHashMapRemove100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := New[int, struct{}](intHasher)
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRemove(b, m, size)
+}
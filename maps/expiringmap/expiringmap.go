@@ -0,0 +1,295 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package expiringmap implements a map whose entries expire after a time to live (TTL).
+//
+// Expired entries are evicted lazily when they are looked up, on every call to Sweep and, optionally,
+// periodically by a background janitor goroutine. The entries are ordered by expiration time in a pairing heap,
+// so that sweeping takes O(log n) amortized time per expired entry and does not touch any live entry.
+// A callback can be registered to be notified of every expired entry.
+//
+// Time is read from a Clock, which can be replaced to advance time deterministically in tests.
+//
+// Structure is thread safe, so that the janitor can sweep the map while it is being used.
+//
+// Reference: https://en.wikipedia.org/wiki/Time_to_live
+package expiringmap
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/trees/pairingheap"
+)
+
+// Assert Map implementation
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
+
+// Clock provides the current time to the map
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Map holds the entries in go's native map and the expiration order of the entries in a pairing heap
+type Map[K comparable, T any] struct {
+	mutex    sync.Mutex
+	entries  map[K]*entry[K, T]
+	expiries *pairingheap.Heap[*entry[K, T]] // entries that expire, ordered by expiration time
+	ttl      time.Duration
+	clock    Clock
+	onExpire func(key K, value T)
+	janitor  chan struct{} // closed to stop the janitor, nil if it is not running
+}
+
+type entry[K comparable, T any] struct {
+	key     K
+	value   T
+	expires time.Time
+	node    *pairingheap.Node[*entry[K, T]] // handle in the heap, nil if the entry does not expire
+}
+
+// New instantiates an expiring map whose entries expire after the default TTL.
+// Entries never expire if the TTL is not positive.
+func New[K comparable, T any](ttl time.Duration) *Map[K, T] {
+	return NewWithClock[K, T](ttl, systemClock{})
+}
+
+// NewWithClock instantiates an expiring map whose entries expire after the default TTL measured by the clock.
+// Entries never expire if the TTL is not positive.
+func NewWithClock[K comparable, T any](ttl time.Duration, clock Clock) *Map[K, T] {
+	return &Map[K, T]{
+		entries: make(map[K]*entry[K, T]),
+		expiries: pairingheap.NewWith(func(a, b *entry[K, T]) int {
+			return a.expires.Compare(b.expires)
+		}),
+		ttl:   ttl,
+		clock: clock,
+	}
+}
+
+// OnExpire registers the function to be called with the key and value of every entry evicted because it expired.
+// Entries that are removed, replaced or cleared are not passed to the function.
+// The function is called without holding the lock of the map, so it may use the map.
+func (m *Map[K, T]) OnExpire(f func(key K, value T)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onExpire = f
+}
+
+// Put inserts the entry into the map, expiring after the default TTL.
+// Replacing an entry restarts its TTL.
+func (m *Map[K, T]) Put(key K, value T) {
+	m.PutWithTTL(key, value, m.ttl)
+}
+
+// PutWithTTL inserts the entry into the map, expiring after the given TTL instead of the default TTL.
+// The entry never expires if the TTL is not positive.
+func (m *Map[K, T]) PutWithTTL(key K, value T, ttl time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.remove(key)
+	e := &entry[K, T]{key: key, value: value}
+	if ttl > 0 {
+		e.expires = m.clock.Now().Add(ttl)
+		e.node = m.expiries.Insert(e)
+	}
+	m.entries[key] = e
+}
+
+// Get searches the entry in the map by key and returns its value or nil if key is not found in map.
+// An expired entry is evicted and not found.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, T]) Get(key K) (value T, found bool) {
+	m.mutex.Lock()
+	e, found := m.entries[key]
+	if found && m.expired(e, m.clock.Now()) {
+		m.remove(key)
+		onExpire := m.onExpire
+		m.mutex.Unlock()
+		if onExpire != nil {
+			onExpire(e.key, e.value)
+		}
+		return value, false
+	}
+	m.mutex.Unlock()
+	if !found {
+		return value, false
+	}
+	return e.value, true
+}
+
+// ExpiresAt returns the time at which the entry expires.
+// Second return parameter is false if key was not found or the entry expired, and the time is zero if the entry
+// never expires.
+func (m *Map[K, T]) ExpiresAt(key K) (time.Time, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e, found := m.entries[key]
+	if !found || m.expired(e, m.clock.Now()) {
+		return time.Time{}, false
+	}
+	return e.expires, true
+}
+
+// Remove removes the entry from the map by key.
+func (m *Map[K, T]) Remove(key K) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.remove(key)
+}
+
+// Sweep evicts all expired entries and returns their number.
+// Takes O(log n) amortized time per evicted entry.
+func (m *Map[K, T]) Sweep() int {
+	m.mutex.Lock()
+	expired := m.sweep()
+	onExpire := m.onExpire
+	m.mutex.Unlock()
+	if onExpire != nil {
+		for _, e := range expired {
+			onExpire(e.key, e.value)
+		}
+	}
+	return len(expired)
+}
+
+// StartJanitor starts a goroutine sweeping the map at every interval, stopping a running janitor first.
+// The janitor waits for the interval in real time, regardless of the clock of the map.
+// A running janitor keeps the map reachable, so it is not garbage collected until StopJanitor is called.
+// Panics if the interval is not positive.
+func (m *Map[K, T]) StartJanitor(interval time.Duration) {
+	if interval <= 0 {
+		panic("Invalid interval, should be positive")
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stopJanitor()
+	stop := make(chan struct{})
+	m.janitor = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.Sweep()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// StopJanitor stops the janitor, if it is running.
+func (m *Map[K, T]) StopJanitor() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.stopJanitor()
+}
+
+// Stops the janitor, if it is running, the mutex has to be held
+func (m *Map[K, T]) stopJanitor() {
+	if m.janitor != nil {
+		close(m.janitor)
+		m.janitor = nil
+	}
+}
+
+// Empty returns true if map does not contain any unexpired entries
+func (m *Map[K, T]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of unexpired entries in the map, evicting expired entries first.
+func (m *Map[K, T]) Size() int {
+	m.Sweep()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.entries)
+}
+
+// Keys returns the keys of all unexpired entries (random order), evicting expired entries first.
+func (m *Map[K, T]) Keys() []K {
+	m.Sweep()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	keys := make([]K, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values returns the values of all unexpired entries (random order), evicting expired entries first.
+func (m *Map[K, T]) Values() []T {
+	m.Sweep()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	values := make([]T, 0, len(m.entries))
+	for _, e := range m.entries {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// Clear removes all entries from the map.
+func (m *Map[K, T]) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.entries = make(map[K]*entry[K, T])
+	m.expiries.Clear()
+}
+
+// String returns a string representation of container
+func (m *Map[K, T]) String() string {
+	m.Sweep()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	values := make(map[K]T, len(m.entries))
+	for key, e := range m.entries {
+		values[key] = e.value
+	}
+	str := "ExpiringMap\n"
+	str += fmt.Sprintf("%v", values)
+	return str
+}
+
+// Check whether the entry expired at the given time
+func (m *Map[K, T]) expired(e *entry[K, T], now time.Time) bool {
+	return e.node != nil && !now.Before(e.expires)
+}
+
+// Remove the entry from the map and the heap, the lock has to be held
+func (m *Map[K, T]) remove(key K) {
+	if e, found := m.entries[key]; found {
+		if e.node != nil {
+			m.expiries.Delete(e.node)
+		}
+		delete(m.entries, key)
+	}
+}
+
+// Evict and return the expired entries in order of expiration, the lock has to be held
+func (m *Map[K, T]) sweep() []*entry[K, T] {
+	var expired []*entry[K, T]
+	now := m.clock.Now()
+	for {
+		e, ok := m.expiries.Peek()
+		if !ok || !m.expired(e, now) {
+			return expired
+		}
+		m.expiries.Pop()
+		delete(m.entries, e.key)
+		expired = append(expired, e)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package expiringmap
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
+)
+
+// fakeClock is a clock whose time only moves when it is advanced
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	clock.now = clock.now.Add(d)
+}
+
+func sortedKeys(m *Map[int, string]) []int {
+	keys := m.Keys()
+	slices.Sort(keys)
+	return keys
+}
+
+func TestMapPutGet(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, string](time.Minute, clock)
+	m.Put(1, "a")
+	clock.Advance(30 * time.Second)
+	m.Put(2, "b")
+	m.PutWithTTL(3, "c", time.Hour)
+	m.PutWithTTL(4, "d", 0)
+
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	clock.Advance(30 * time.Second)
+	if actualValue, found := m.Get(1); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue, found := m.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	clock.Advance(time.Hour)
+	if actualValue, found := m.Get(3); actualValue != "" || found {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue, found := m.Get(4); actualValue != "d" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "d")
+	}
+	if actualValue, expectedValue := fmt.Sprint(sortedKeys(m)), "[4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapReplaceRestartsTTL(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, string](time.Minute, clock)
+	m.Put(1, "a")
+	clock.Advance(50 * time.Second)
+	m.Put(1, "b")
+	clock.Advance(50 * time.Second)
+	if actualValue, found := m.Get(1); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, found := m.ExpiresAt(1); !actualValue.Equal(clock.Now().Add(10*time.Second)) || !found {
+		t.Errorf("Got %v expected %v", actualValue, clock.Now().Add(10*time.Second))
+	}
+	m.PutWithTTL(1, "c", 0)
+	if actualValue, found := m.ExpiresAt(1); !actualValue.IsZero() || !found {
+		t.Errorf("Got %v expected %v", actualValue, time.Time{})
+	}
+	clock.Advance(time.Hour)
+	if actualValue, expectedValue := m.Sweep(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.ExpiresAt(2); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapSweep(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, string](time.Minute, clock)
+	var expired []string
+	m.OnExpire(func(key int, value string) {
+		expired = append(expired, fmt.Sprintf("%d:%s", key, value))
+		if key < 5 {
+			m.Put(key*10, value) // the map can be used from the callback
+		}
+	})
+	for _, key := range []int{5, 3, 1, 4, 2} {
+		m.PutWithTTL(key, strings.Repeat("x", key), time.Duration(key)*time.Second)
+	}
+	m.Put(6, "y")
+	m.Remove(2)
+
+	clock.Advance(4 * time.Second)
+	if actualValue, expectedValue := m.Sweep(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(expired), "[1:x 3:xxx 4:xxxx]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(sortedKeys(m)), "[5 6 10 30 40]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.Advance(time.Minute)
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	slices.Sort(expired[3:]) // entries expiring at the same time are evicted in any order
+	if actualValue, expectedValue := fmt.Sprint(expired[3:]), "[10:x 30:xxx 40:xxxx 5:xxxxx 6:y]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.OnExpire(nil)
+	m.Put(1, "a")
+	m.Clear()
+	if actualValue, expectedValue := m.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapLazyEviction(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, string](time.Minute, clock)
+	var expired []int
+	m.OnExpire(func(key int, value string) { expired = append(expired, key) })
+	m.Put(1, "a")
+	m.Put(2, "b")
+	clock.Advance(time.Minute)
+	m.Get(2)
+	if actualValue, expectedValue := fmt.Sprint(expired), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(m.entries), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Values(), []string{}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(expired), "[2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapJanitor(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, string](time.Minute, clock)
+	expired := make(chan int, 10)
+	m.OnExpire(func(key int, value string) { expired <- key })
+	m.StartJanitor(time.Millisecond)
+	m.StartJanitor(time.Millisecond) // restarts the janitor
+	defer m.StopJanitor()
+	m.Put(1, "a")
+	m.PutWithTTL(2, "b", time.Hour)
+	clock.Advance(time.Minute)
+	select {
+	case key := <-expired:
+		if actualValue, expectedValue := key, 1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Janitor did not evict the expired entry")
+	}
+	m.StopJanitor()
+	clock.Advance(time.Hour)
+	time.Sleep(10 * time.Millisecond)
+	if actualValue, expectedValue := len(expired), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapJanitorInvalidInterval(t *testing.T) {
+	m := New[int, string](time.Minute)
+	for _, interval := range []time.Duration{0, -time.Second} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Got no panic for interval %v", interval)
+				}
+			}()
+			m.StartJanitor(interval)
+		}()
+	}
+	if actualValue := m.janitor; actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapJanitorConcurrentStart(t *testing.T) {
+	before := runtime.NumGoroutine()
+	m := New[int, string](time.Minute)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.StartJanitor(time.Hour)
+		}()
+	}
+	wg.Wait()
+	m.StopJanitor()
+	deadline := time.Now().Add(10 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("Got %v goroutines expected %v", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMapConcurrentUse(t *testing.T) {
+	clock := newFakeClock()
+	m := NewWithClock[int, int](time.Second, clock)
+	m.StartJanitor(time.Microsecond)
+	defer m.StopJanitor()
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				m.Put(worker*1000+i, i)
+				m.Get(worker*1000 + i/2)
+				clock.Advance(time.Millisecond)
+			}
+		}(worker)
+	}
+	wg.Wait()
+	clock.Advance(time.Second)
+	if actualValue, expectedValue := m.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapString(t *testing.T) {
+	m := New[string, int](time.Hour)
+	m.Put("a", 1)
+	if !strings.HasPrefix(m.String(), "ExpiringMap") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := m.String(), "ExpiringMap\nmap[a:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return New[int, string](time.Hour) })
+}
+
+func BenchmarkExpiringMapPutSweep100000(b *testing.B) {
+	clock := newFakeClock()
+	m := NewWithClock[int, int](time.Second, clock)
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < 100000; n++ {
+			m.Put(n, n)
+			clock.Advance(time.Microsecond)
+		}
+		clock.Advance(time.Second)
+		m.Sweep()
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashbidimap
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return &Map[K, T]{*m.forwardMap.Clone(), *m.inverseMap.Clone()}
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	clone := New[K, T]()
+	for _, key := range m.forwardMap.Keys() {
+		value, _ := m.forwardMap.Get(key)
+		clone.Put(key, copyValue(value))
+	}
+	return clone
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	return m.forwardMap.Equal(&other.forwardMap)
+}
@@ -35,8 +35,25 @@ func New[K, T comparable]() *Map[K, T] {
 	return &Map[K, T]{*hashmap.New[K, T](), *hashmap.New[T, K]()}
 }
 
-// Put inserts element into the map.
+// Put inserts element into the map (same as ForcePut()).
+// If the value is already mapped to another key, that key is removed from the map.
 func (m *Map[K, T]) Put(key K, value T) {
+	m.ForcePut(key, value)
+}
+
+// PutStrict inserts element into the map, unless the value is already mapped to another key,
+// in which case maps.ErrValueExists is returned and the map is left unchanged.
+func (m *Map[K, T]) PutStrict(key K, value T) error {
+	if keyByValue, ok := m.inverseMap.Get(value); ok && keyByValue != key {
+		return maps.ErrValueExists
+	}
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the map.
+// If the value is already mapped to another key, that key is removed from the map.
+func (m *Map[K, T]) ForcePut(key K, value T) {
 	if valueByKey, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(valueByKey)
 	}
@@ -47,6 +64,12 @@ func (m *Map[K, T]) Put(key K, value T) {
 	m.inverseMap.Put(value, key)
 }
 
+// Inverse returns a live view of the map with keys and values swapped.
+// Changes to the view are reflected in the map and vice versa.
+func (m *Map[K, T]) Inverse() maps.BidiMap[T, K] {
+	return maps.NewInverseView[K, T](m)
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
 // Second return parameter is true if key was found, otherwise false.
 func (m *Map[K, T]) Get(key K) (value T, found bool) {
@@ -7,6 +7,8 @@ package hashbidimap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -222,6 +224,150 @@ func sameElements(a []interface{}, b []interface{}) bool {
 	return true
 }
 
+func TestMapClone(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, "d")
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.GetKey("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if _, found := m.GetKey("d"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	deepClone := m.DeepClone(strings.ToUpper)
+	if actualValue, found := deepClone.Get(1); actualValue != "A" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "A")
+	}
+	if actualValue, found := deepClone.GetKey("B"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	other := New[int, string]()
+	other.Put(2, "b")
+	other.Put(1, "a")
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(2, "c")
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapPutStrict(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	if err := m.PutStrict(3, "a"); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	if err := m.PutStrict(1, "a"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := m.PutStrict(2, "c"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := m.PutStrict(3, "b"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.ForcePut(4, "a")
+	tests := [][]interface{}{
+		{1, "", false},
+		{2, "c", true},
+		{3, "b", true},
+		{4, "a", true},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapInverse(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	inverse := m.Inverse()
+	if actualValue, found := inverse.Get("b"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := inverse.GetKey(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	inverse.Put("c", 3)
+	if actualValue, found := m.Get(3); actualValue != "c" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if err := inverse.PutStrict("d", 4); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := inverse.PutStrict("e", 2); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	inverse.ForcePut("b", 1)
+	inverse.Remove("c")
+	inverse.Remove("d")
+	inverse.Remove("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", inverse.Keys()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", inverse.Values()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := inverse.String(), "InverseBidiMap\nmap[b:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(5, "e")
+	if actualValue, expectedValue := inverse.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := inverse.Inverse(); actualValue != maps.BidiMap[int, string](m) {
+		t.Errorf("Got %v expected %v", actualValue, m)
+	}
+	if actualValue := maps.NewInverseView(inverse); actualValue != maps.BidiMap[int, string](m) {
+		t.Errorf("Got %v expected %v", actualValue, m)
+	}
+	inverse.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := inverse.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return New[int, string]() })
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
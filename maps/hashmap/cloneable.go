@@ -0,0 +1,44 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashmap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return m.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	clone := &Map[K, T]{m: make(map[K]T, len(m.m))}
+	for key, value := range m.m {
+		clone.m[key] = copyValue(value)
+	}
+	return clone
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+// Values are compared with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for key, value := range m.m {
+		otherValue, found := other.m[key]
+		if !found || !equal(value, otherValue) {
+			return false
+		}
+	}
+	return true
+}
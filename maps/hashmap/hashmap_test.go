@@ -7,6 +7,8 @@ package hashmap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -190,6 +192,71 @@ func sameElements(a []interface{}, b []interface{}) bool {
 	return true
 }
 
+func TestMapClone(t *testing.T) {
+	m := New[int, []int]()
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	m.Put(3, []int{3})
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, []int{4})
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	deepClone := m.DeepClone(func(value []int) []int { return append([]int(nil), value...) })
+	if actualValue := deepClone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	value, _ := deepClone.Get(2)
+	value[0] = 20
+	if actualValue, _ := m.Get(2); actualValue[0] != 2 {
+		t.Errorf("Got %v expected %v", actualValue[0], 2)
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	shallowClone := m.Clone()
+	value, _ = shallowClone.Get(3)
+	value[0] = 30
+	if actualValue, _ := m.Get(3); actualValue[0] != 30 {
+		t.Errorf("Got %v expected %v", actualValue[0], 30)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := New[int, []int]()
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	other := New[int, []int]()
+	other.Put(2, []int{2})
+	other.Put(1, []int{1})
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(3, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Remove(3)
+	other.Put(2, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return New[int, string]() })
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, struct{}], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,108 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package maps
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Assert Map implementation
+var _ BidiMap[int, string] = (*inverseView[int, string])(nil)
+
+// inverseView is a BidiMap with keys and values swapped, backed by another BidiMap
+type inverseView[K, T comparable] struct {
+	m BidiMap[T, K]
+}
+
+// NewInverseView returns a live view of the bidirectional map with keys and values swapped.
+// Changes to the view are reflected in the map and vice versa. The inverse view of the view is the map itself.
+func NewInverseView[K, T comparable](m BidiMap[K, T]) BidiMap[T, K] {
+	if view, ok := m.(*inverseView[K, T]); ok {
+		return view.m
+	}
+	return &inverseView[T, K]{m: m}
+}
+
+// Put inserts the value under the key, i.e. the key under the value in the backing map.
+func (view *inverseView[K, T]) Put(key K, value T) {
+	view.m.Put(value, key)
+}
+
+// PutStrict inserts the value under the key, unless the value is already mapped to another key.
+// Keys and values are matched by the backing map, so that its comparators are used.
+func (view *inverseView[K, T]) PutStrict(key K, value T) error {
+	if keyByValue, found := view.m.Get(value); found {
+		// the key is the one of the value if the backing map finds the same entry for both of them
+		storedValue, _ := view.m.GetKey(keyByValue)
+		if valueByKey, found := view.m.GetKey(key); !found || valueByKey != storedValue {
+			return ErrValueExists
+		}
+	}
+	view.m.ForcePut(value, key)
+	return nil
+}
+
+// ForcePut inserts the value under the key, removing the pair of the value if it is mapped to another key.
+func (view *inverseView[K, T]) ForcePut(key K, value T) {
+	view.m.ForcePut(value, key)
+}
+
+// Get returns the value of the key, i.e. the key of the value in the backing map.
+func (view *inverseView[K, T]) Get(key K) (value T, found bool) {
+	return view.m.GetKey(key)
+}
+
+// GetKey returns the key of the value, i.e. the value of the key in the backing map.
+func (view *inverseView[K, T]) GetKey(value T) (key K, found bool) {
+	return view.m.Get(value)
+}
+
+// Remove removes the element from the map by key.
+func (view *inverseView[K, T]) Remove(key K) {
+	if value, found := view.m.GetKey(key); found {
+		view.m.Remove(value)
+	}
+}
+
+// Inverse returns the backing map.
+func (view *inverseView[K, T]) Inverse() BidiMap[T, K] {
+	return view.m
+}
+
+// Keys returns all keys, i.e. the values of the backing map in the order returned by its Values.
+func (view *inverseView[K, T]) Keys() []K {
+	return view.m.Values()
+}
+
+// Values returns all values, i.e. the keys of the backing map in the order returned by its Keys.
+func (view *inverseView[K, T]) Values() []T {
+	return view.m.Keys()
+}
+
+// Empty returns true if map does not contain any elements
+func (view *inverseView[K, T]) Empty() bool {
+	return view.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (view *inverseView[K, T]) Size() int {
+	return view.m.Size()
+}
+
+// Clear removes all elements from the map.
+func (view *inverseView[K, T]) Clear() {
+	view.m.Clear()
+}
+
+// String returns a string representation of container
+func (view *inverseView[K, T]) String() string {
+	str := "InverseBidiMap\nmap["
+	for _, value := range view.m.Keys() {
+		key, _ := view.m.Get(value)
+		str += fmt.Sprintf("%v:%v ", key, value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
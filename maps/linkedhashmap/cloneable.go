@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashmap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs in the same insertion-order.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return m.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	clone := &Map[K, T]{
+		table:    make(map[K]T, len(m.table)),
+		ordering: m.ordering.Clone(),
+	}
+	for key, value := range m.table {
+		clone.table[key] = copyValue(value)
+	}
+	return clone
+}
+
+// Equal returns true if both maps hold the same key-value pairs in the same insertion-order.
+// Values are compared with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	if !m.ordering.Equal(other.ordering) {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for key, value := range m.table {
+		if !equal(value, other.table[key]) {
+			return false
+		}
+	}
+	return true
+}
@@ -13,16 +13,16 @@ import (
 var _ containers.ReverseIteratorWithKey[int, int] = (*Iterator[int, int])(nil)
 
 // Iterator holding the iterator's state
-type Iterator[K, T comparable] struct {
+type Iterator[K comparable, T any] struct {
 	iterator doublylinkedlist.Iterator[K]
-	table    map[K]T
+	m        *Map[K, T]
 }
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[K, T]) Iterator() Iterator[K, T] {
 	return Iterator[K, T]{
 		iterator: m.ordering.Iterator(),
-		table:    m.table}
+		m:        m}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -44,7 +44,7 @@ func (iterator *Iterator[K, T]) Prev() bool {
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Value() T {
 	key := iterator.iterator.Value()
-	return iterator.table[key]
+	return iterator.m.table[key]
 }
 
 // Key returns the current element's key.
@@ -106,3 +106,29 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the map and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing through the iterator is the only modification of the map that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Remove() bool {
+	element := iterator.iterator.Element()
+	if element == nil {
+		return false
+	}
+	iterator.iterator.Remove()
+	delete(iterator.m.table, element.Value)
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Set(value T) bool {
+	element := iterator.iterator.Element()
+	if element == nil {
+		return false
+	}
+	iterator.m.table[element.Value] = value
+	return true
+}
@@ -7,6 +7,9 @@ package linkedhashmap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -624,6 +627,194 @@ func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
 	}
 }
 
+func TestMapClone(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, "d")
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(m.Keys())...), "312"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := m.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Keys())...), "312"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := deepClone.Get(1); actualValue != "A" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "A")
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	other := New[int, string]()
+	other.Put(2, "b")
+	other.Put(1, "a")
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Clear()
+	other.Put(1, "a")
+	other.Put(2, "c")
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Put(2, "b")
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	it := m.Iterator()
+	it.Next()
+	m.Put(1, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(4, "d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Key() })
+
+	it.Begin()
+	m.Remove(1) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestMapIteratorRemove(t *testing.T) {
+	m := New[int, int]()
+	for i := 1; i <= 20; i++ {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []int
+	for it.Next() {
+		visited = append(visited, it.Key())
+		if it.Key()%2 == 0 {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		} else if actualValue, expectedValue := it.Set(it.Value()*10), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 3 5 7 9 11 13 15 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[10 30 50 70 90 110 130 150 170 190]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	visited = nil
+	it.End()
+	for it.Prev() {
+		visited = append(visited, it.Key())
+		if it.Key()%3 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[19 17 15 13 11 9 7 5 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 5 7 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// changing direction after a removal
+	it.First()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return New[int, string]() })
+}
+
+func TestMapIteratorConformance(t *testing.T) {
+	containertest.TestIteratorWithKey(t, func(keys []int, values []string) containers.IteratorWithKey[int, string] {
+		m := New[int, string]()
+		for index, key := range keys {
+			m.Put(key, values[index])
+		}
+		it := m.Iterator()
+		return &it
+	})
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, struct{}], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
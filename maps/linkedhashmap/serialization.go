@@ -27,7 +27,7 @@ func (m *Map[K, T]) ToJSON() ([]byte, error) {
 	index := 0
 
 	for it.Next() {
-		km, err := json.Marshal(it.Key())
+		km, err := json.Marshal(utils.ToString(it.Key()))
 		if err != nil {
 			return nil, err
 		}
@@ -78,7 +78,7 @@ func (m *Map[K, T]) FromJSON(data []byte) error {
 	var keys []K
 	for key := range elements {
 		keys = append(keys, key)
-		esc, _ := json.Marshal(key)
+		esc, _ := json.Marshal(utils.ToString(key))
 		index[key] = bytes.Index(data, esc)
 	}
 
@@ -15,10 +15,17 @@
 // Reference: https://en.wikipedia.org/wiki/Associative_array
 package maps
 
-import "github.com/ugurcsen/gods-generic/containers"
+import (
+	"errors"
+
+	"github.com/ugurcsen/gods-generic/containers"
+)
+
+// ErrValueExists is returned by PutStrict of bidirectional maps when the value is already mapped to another key.
+var ErrValueExists = errors.New("maps: value is already mapped to another key")
 
 // Map interface that all maps implement
-type Map[K, T comparable] interface {
+type Map[K comparable, T any] interface {
 	Put(key K, value T)
 	Get(key K) (value T, found bool)
 	Remove(key K)
@@ -33,8 +40,14 @@ type Map[K, T comparable] interface {
 }
 
 // BidiMap interface that all bidirectional maps implement (extends the Map interface)
+//
+// Put and ForcePut remove the pair of a value that is already mapped to another key,
+// PutStrict returns ErrValueExists instead and leaves the map unchanged.
 type BidiMap[K, T comparable] interface {
 	GetKey(value T) (key K, found bool)
+	PutStrict(key K, value T) error
+	ForcePut(key K, value T)
+	Inverse() BidiMap[T, K]
 
 	Map[K, T]
 }
@@ -0,0 +1,47 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sortedslicemap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+	"slices"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+// Both slices are copied directly in O(n) without any searching.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	clone := m.empty()
+	clone.keys, clone.values = slices.Clone(m.keys), slices.Clone(m.values)
+	return clone
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	clone := m.Clone()
+	for index, value := range clone.values {
+		clone.values[index] = copyValue(value)
+	}
+	return clone
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+// Keys and values are compared with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	if len(m.keys) != len(other.keys) {
+		return false
+	}
+	keyEqual, valueEqual := utils.DefaultEquality[K](), utils.DefaultEquality[T]()
+	for index, key := range m.keys {
+		if !keyEqual(key, other.keys[index]) || !valueEqual(m.values[index], other.values[index]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sortedslicemap
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithKey[int, int] = (*Iterator[int, int])(nil)
+
+// Iterator holding the iterator's state
+type Iterator[K comparable, T any] struct {
+	m       *Map[K, T]
+	index   int
+	version int
+	removed bool // current element was removed through the iterator
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator panics with containers.ErrConcurrentModification if the map is modified during iteration.
+func (m *Map[K, T]) Iterator() Iterator[K, T] {
+	return Iterator[K, T]{m: m, index: -1, version: m.version}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.m.version
+	}
+	iterator.checkVersion()
+	if iterator.removed {
+		// the element that followed the removed one has moved to its index
+		iterator.removed = false
+		return iterator.withinRange()
+	}
+	if iterator.index < iterator.m.Size() {
+		iterator.index++
+	}
+	return iterator.withinRange()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Prev() bool {
+	iterator.checkVersion()
+	iterator.removed = false
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.withinRange()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Value() T {
+	iterator.checkVersion()
+	return iterator.m.values[iterator.index]
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Key() K {
+	iterator.checkVersion()
+	return iterator.m.keys[iterator.index]
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[K, T]) Begin() {
+	iterator.index = -1
+	iterator.version = iterator.m.version
+	iterator.removed = false
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[K, T]) End() {
+	iterator.index = iterator.m.Size()
+	iterator.version = iterator.m.version
+	iterator.removed = false
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator[K, T]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) NextTo(f func(key K, value T) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the current element from the map and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing through the iterator is the only modification of the map that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.withinRange() {
+		return false
+	}
+	iterator.m.removeAt(iterator.index)
+	iterator.version = iterator.m.version
+	iterator.removed = true
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.removed || !iterator.withinRange() {
+		return false
+	}
+	iterator.m.values[iterator.index] = value
+	return true
+}
+
+// withinRange returns true if the iterator points to an element of the map.
+func (iterator *Iterator[K, T]) withinRange() bool {
+	return iterator.index >= 0 && iterator.index < iterator.m.Size()
+}
+
+// checkVersion panics if the map was modified since the iterator was created or reset.
+func (iterator *Iterator[K, T]) checkVersion() {
+	if iterator.version != iterator.m.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sortedslicemap implements a map backed by a sorted slice.
+//
+// Elements are ordered by key in the map.
+//
+// Keys and values are kept in two contiguous slices sorted by key and looked up with binary search.
+// Compared to a tree map there is no per-entry allocation and iteration has good locality,
+// at the cost of O(n) insertion and removal. Best suited for small or read-mostly maps.
+// Maps created with NewWithNumberComparator or NewWithStringComparator search without calling the comparator.
+// In BenchmarkTreeMapVsSortedSliceMap of package treemap, all operations are faster than in a tree map up to
+// a hundred entries, and only removal in random order breaks even at a thousand entries.
+//
+// Structure is not thread safe.
+//
+// Reference: http://en.wikipedia.org/wiki/Associative_array
+package sortedslicemap
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/utils"
+	"slices"
+	"strings"
+)
+
+// Assert Map implementation
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
+
+// Map holds the elements in two slices sorted by key
+type Map[K comparable, T any] struct {
+	keys       []K
+	values     []T
+	comparator utils.Comparator[K]
+	find       func(keys []K, key K) (int, bool) // binary search, specialized for the number and string comparators
+	version    int                               // incremented on every modification, used by iterators to detect concurrent modification
+}
+
+// NewWith instantiates a sorted slice map with the custom comparator.
+func NewWith[K comparable, T any](comparator utils.Comparator[K]) *Map[K, T] {
+	return &Map[K, T]{comparator: comparator, find: searchFunc(comparator)}
+}
+
+// NewWithNumberComparator instantiates a sorted slice map with the IntComparator, i.e. keys are of type int.
+func NewWithNumberComparator[T any]() *Map[int, T] {
+	return &Map[int, T]{comparator: utils.NumberComparator[int], find: slices.BinarySearch[[]int]}
+}
+
+// NewWithStringComparator instantiates a sorted slice map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator[T any]() *Map[string, T] {
+	return &Map[string, T]{comparator: utils.StringComparator, find: slices.BinarySearch[[]string]}
+}
+
+// FromSorted instantiates a sorted slice map with the custom comparator from keys that are already
+// in ascending order without duplicates and their corresponding values, in O(n).
+// The passed slices are copied.
+// Panics if the slices are of different lengths or the keys are not strictly ascending.
+func FromSorted[K comparable, T any](comparator utils.Comparator[K], keys []K, values []T) *Map[K, T] {
+	if len(keys) != len(values) {
+		panic("Invalid values, should be as many as keys")
+	}
+	for i := 1; i < len(keys); i++ {
+		if comparator(keys[i-1], keys[i]) >= 0 {
+			panic("Invalid keys, should be in ascending order without duplicates")
+		}
+	}
+	return &Map[K, T]{keys: slices.Clone(keys), values: slices.Clone(values), comparator: comparator, find: searchFunc(comparator)}
+}
+
+// Put inserts key-value pair into the map.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Put(key K, value T) {
+	index, found := m.search(key)
+	if found {
+		m.values[index] = value
+		return
+	}
+	m.version++
+	m.keys = slices.Insert(m.keys, index, key)
+	m.values = slices.Insert(m.values, index, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Get(key K) (value T, found bool) {
+	if index, found := m.search(key); found {
+		return m.values[index], true
+	}
+	return value, false
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Remove(key K) {
+	if index, found := m.search(key); found {
+		m.removeAt(index)
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, T]) Empty() bool {
+	return len(m.keys) == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, T]) Size() int {
+	return len(m.keys)
+}
+
+// Keys returns all keys in-order
+func (m *Map[K, T]) Keys() []K {
+	return slices.Clone(m.keys)
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map[K, T]) Values() []T {
+	return slices.Clone(m.values)
+}
+
+// Clear removes all elements from the map.
+func (m *Map[K, T]) Clear() {
+	m.version++
+	m.keys = nil
+	m.values = nil
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map[K, T]) Min() (key K, value T) {
+	if len(m.keys) == 0 {
+		return key, value
+	}
+	return m.keys[0], m.values[0]
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map[K, T]) Max() (key K, value T) {
+	if len(m.keys) == 0 {
+		return key, value
+	}
+	return m.keys[len(m.keys)-1], m.values[len(m.values)-1]
+}
+
+// Floor finds the floor key-value pair for the input key.
+// Third return parameter is true if floor was found, otherwise false.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Floor(key K) (foundKey K, foundValue T, found bool) {
+	index, found := m.search(key)
+	if !found {
+		index--
+	}
+	return m.entry(index)
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// Third return parameter is true if ceiling was found, otherwise false.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Ceiling(key K) (foundKey K, foundValue T, found bool) {
+	index, _ := m.search(key)
+	return m.entry(index)
+}
+
+// Lower finds the lower key-value pair for the input key.
+// Third return parameter is true if lower key was found, otherwise false.
+//
+// Lower key is defined as the largest key that is strictly smaller than the given key.
+// A lower key may not be found, either because the map is empty, or because
+// all keys in the map are larger than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Lower(key K) (foundKey K, foundValue T, found bool) {
+	index, _ := m.search(key)
+	return m.entry(index - 1)
+}
+
+// Higher finds the higher key-value pair for the input key.
+// Third return parameter is true if higher key was found, otherwise false.
+//
+// Higher key is defined as the smallest key that is strictly larger than the given key.
+// A higher key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Higher(key K) (foundKey K, foundValue T, found bool) {
+	index, found := m.search(key)
+	if found {
+		index++
+	}
+	return m.entry(index)
+}
+
+// FirstEntry returns the minimum key and its value from the map.
+// Third return parameter is true if the map is not empty, otherwise false.
+func (m *Map[K, T]) FirstEntry() (key K, value T, found bool) {
+	return m.entry(0)
+}
+
+// LastEntry returns the maximum key and its value from the map.
+// Third return parameter is true if the map is not empty, otherwise false.
+func (m *Map[K, T]) LastEntry() (key K, value T, found bool) {
+	return m.entry(len(m.keys) - 1)
+}
+
+// PollFirst removes the minimum key from the map and returns it and its value.
+// Third return parameter is true if the map was not empty, otherwise false.
+func (m *Map[K, T]) PollFirst() (key K, value T, found bool) {
+	if key, value, found = m.FirstEntry(); found {
+		m.removeAt(0)
+	}
+	return key, value, found
+}
+
+// PollLast removes the maximum key from the map and returns it and its value.
+// Third return parameter is true if the map was not empty, otherwise false.
+func (m *Map[K, T]) PollLast() (key K, value T, found bool) {
+	if key, value, found = m.LastEntry(); found {
+		m.removeAt(len(m.keys) - 1)
+	}
+	return key, value, found
+}
+
+// String returns a string representation of container
+func (m *Map[K, T]) String() string {
+	str := "SortedSliceMap\nmap["
+	for index, key := range m.keys {
+		str += fmt.Sprintf("%v:%v ", key, m.values[index])
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// search returns the index of the key and true if the key is in the map,
+// otherwise the index at which the key would be inserted and false.
+func (m *Map[K, T]) search(key K) (int, bool) {
+	if len(m.keys) == 0 {
+		return 0, false
+	}
+	return m.find(m.keys, key)
+}
+
+// searchFunc returns a binary search over keys sorted with the comparator.
+func searchFunc[K comparable](comparator utils.Comparator[K]) func(keys []K, key K) (int, bool) {
+	return func(keys []K, key K) (int, bool) {
+		return slices.BinarySearchFunc(keys, key, comparator)
+	}
+}
+
+// empty returns an empty map with the comparator and search of the map.
+func (m *Map[K, T]) empty() *Map[K, T] {
+	return &Map[K, T]{comparator: m.comparator, find: m.find}
+}
+
+// entry returns the key and value at the given index and true, or false if the index is out of bounds.
+func (m *Map[K, T]) entry(index int) (key K, value T, found bool) {
+	if index < 0 || index >= len(m.keys) {
+		return key, value, false
+	}
+	return m.keys[index], m.values[index], true
+}
+
+// removeAt removes the element at the given index, which must be within bounds.
+func (m *Map[K, T]) removeAt(index int) {
+	m.version++
+	var emptyK K
+	var emptyT T
+	last := len(m.keys) - 1
+	copy(m.keys[index:], m.keys[index+1:])
+	copy(m.values[index:], m.values[index+1:])
+	m.keys[last], m.values[last] = emptyK, emptyT // cleanup references
+	m.keys, m.values = m.keys[:last], m.values[:last]
+}
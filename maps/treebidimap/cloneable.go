@@ -0,0 +1,61 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treebidimap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+// Both underlying trees are copied directly in O(n) without any rebalancing.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return m.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+// The copy function must not change the ordering of the values with respect to the map's value comparator.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	copies := make(map[*data[K, T]]*data[K, T], m.Size())
+	copyData := func(d *data[K, T]) *data[K, T] {
+		if c, found := copies[d]; found {
+			return c
+		}
+		c := &data[K, T]{key: d.key, value: copyValue(d.value)}
+		copies[d] = c
+		return c
+	}
+	forwardMap := m.forwardMap.DeepClone(copyData)
+	inverseMap := m.inverseMap.DeepClone(copyData)
+	for it := inverseMap.Iterator(); it.Next(); {
+		it.Node().Key = it.Value().value
+	}
+	return &Map[K, T]{
+		forwardMap:      *forwardMap,
+		inverseMap:      *inverseMap,
+		keyComparator:   m.keyComparator,
+		valueComparator: m.valueComparator,
+	}
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+// Keys and values are compared with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	if m.Size() != other.Size() {
+		return false
+	}
+	keyEqual, valueEqual := utils.DefaultEquality[K](), utils.DefaultEquality[T]()
+	it1, it2 := m.Iterator(), other.Iterator()
+	for it1.Next() && it2.Next() {
+		if !keyEqual(it1.Key(), it2.Key()) || !valueEqual(it1.Value(), it2.Value()) {
+			return false
+		}
+	}
+	return true
+}
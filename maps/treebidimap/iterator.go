@@ -6,20 +6,23 @@ package treebidimap
 
 import (
 	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/maps"
 	rbt "github.com/ugurcsen/gods-generic/trees/redblacktree"
 )
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithKey[int, int] = (*Iterator[int, int])(nil)
+var _ containers.ReverseIteratorWithKey[int, int] = (*ValueIterator[int, int])(nil)
 
 // Iterator holding the iterator's state
 type Iterator[K, T comparable] struct {
 	iterator rbt.Iterator[K, *data[K, T]]
+	m        *Map[K, T]
 }
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[K, T]) Iterator() Iterator[K, T] {
-	return Iterator[K, T]{iterator: m.forwardMap.Iterator()}
+	return Iterator[K, T]{iterator: m.forwardMap.Iterator(), m: m}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -102,3 +105,146 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the map and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing and setting through the iterator are the only modifications of the map that do not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Remove() bool {
+	node := iterator.iterator.Node()
+	if node == nil {
+		return false
+	}
+	d := node.Value
+	iterator.iterator.Remove()
+	iterator.m.inverseMap.Remove(d.value)
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// If the value is already mapped to another key, maps.ErrValueExists is returned and the map is left unchanged.
+// The value order of the map is updated, i.e. value iterators of the map are invalidated.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Set(value T) (bool, error) {
+	node := iterator.iterator.Node()
+	if node == nil {
+		return false, nil
+	}
+	d := node.Value
+	if other, found := iterator.m.inverseMap.Get(value); found && other != d {
+		return false, maps.ErrValueExists
+	}
+	iterator.m.inverseMap.Remove(d.value)
+	d.value = value
+	iterator.m.inverseMap.Put(value, d)
+	return true, nil
+}
+
+// ValueIterator holding the iterator's state, visiting the pairs in the order of their values
+type ValueIterator[K, T comparable] struct {
+	iterator rbt.Iterator[T, *data[K, T]]
+	m        *Map[K, T]
+}
+
+// ValueIterator returns a stateful iterator whose elements are key/value pairs ordered by value.
+func (m *Map[K, T]) ValueIterator() ValueIterator[K, T] {
+	return ValueIterator[K, T]{iterator: m.inverseMap.Iterator(), m: m}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *ValueIterator[K, T]) Value() T {
+	return iterator.iterator.Value().value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *ValueIterator[K, T]) Key() K {
+	return iterator.iterator.Value().key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *ValueIterator[K, T]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *ValueIterator[K, T]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *ValueIterator[K, T]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) Last() bool {
+	return iterator.iterator.Last()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) NextTo(f func(key K, value T) bool) bool {
+	for iterator.Next() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
+	for iterator.Prev() {
+		key, value := iterator.Key(), iterator.Value()
+		if f(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the current element from the map and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing through the iterator is the only modification of the map that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *ValueIterator[K, T]) Remove() bool {
+	node := iterator.iterator.Node()
+	if node == nil {
+		return false
+	}
+	d := node.Value
+	iterator.iterator.Remove()
+	iterator.m.forwardMap.Remove(d.key)
+	return true
+}
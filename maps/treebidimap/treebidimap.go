@@ -61,8 +61,25 @@ func NewWithStringComparators() *Map[string, string] {
 	return NewWith(utils.StringComparator, utils.StringComparator)
 }
 
-// Put inserts element into the map.
+// Put inserts element into the map (same as ForcePut()).
+// If the value is already mapped to another key, that key is removed from the map.
 func (m *Map[K, T]) Put(key K, value T) {
+	m.ForcePut(key, value)
+}
+
+// PutStrict inserts element into the map, unless the value is already mapped to another key,
+// in which case maps.ErrValueExists is returned and the map is left unchanged.
+func (m *Map[K, T]) PutStrict(key K, value T) error {
+	if d, ok := m.inverseMap.Get(value); ok && m.keyComparator(d.key, key) != 0 {
+		return maps.ErrValueExists
+	}
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the map.
+// If the value is already mapped to another key, that key is removed from the map.
+func (m *Map[K, T]) ForcePut(key K, value T) {
 	if d, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(d.value)
 	}
@@ -74,6 +91,12 @@ func (m *Map[K, T]) Put(key K, value T) {
 	m.inverseMap.Put(value, d)
 }
 
+// Inverse returns a live view of the map with keys and values swapped.
+// Changes to the view are reflected in the map and vice versa.
+func (m *Map[K, T]) Inverse() maps.BidiMap[T, K] {
+	return maps.NewInverseView[K, T](m)
+}
+
 // Get searches the element in the map by key and returns its value or nil if key is not found in map.
 // Second return parameter is true if key was found, otherwise false.
 func (m *Map[K, T]) Get(key K) (value T, found bool) {
@@ -128,6 +151,26 @@ func (m *Map[K, T]) Clear() {
 	m.inverseMap.Clear()
 }
 
+// FloorByValue finds the pair with the largest value that is smaller than or equal to the given value.
+// Third return parameter is true if such a pair was found, i.e. the map is not empty and not all values in the map
+// are larger than the given value.
+func (m *Map[K, T]) FloorByValue(value T) (foundKey K, foundValue T, found bool) {
+	if node, ok := m.inverseMap.Floor(value); ok {
+		return node.Value.key, node.Value.value, true
+	}
+	return foundKey, foundValue, false
+}
+
+// CeilingByValue finds the pair with the smallest value that is larger than or equal to the given value.
+// Third return parameter is true if such a pair was found, i.e. the map is not empty and not all values in the map
+// are smaller than the given value.
+func (m *Map[K, T]) CeilingByValue(value T) (foundKey K, foundValue T, found bool) {
+	if node, ok := m.inverseMap.Ceiling(value); ok {
+		return node.Value.key, node.Value.value, true
+	}
+	return foundKey, foundValue, false
+}
+
 // String returns a string representation of container
 func (m *Map[K, T]) String() string {
 	str := "TreeBidiMap\nmap["
@@ -7,6 +7,9 @@ package treebidimap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -657,6 +660,429 @@ func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
 	}
 }
 
+func TestMapClone(t *testing.T) {
+	m := NewWith[int, string](utils.NumberComparator[int], utils.StringComparator)
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, "d")
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(m.Keys())...), "123"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := m.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Keys())...), "123"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := deepClone.Get(1); actualValue != "A" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "A")
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, found := deepClone.GetKey("B"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if _, found := deepClone.GetKey("b"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	if actualValue, found := clone.GetKey("d"); actualValue != 4 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if _, found := m.GetKey("d"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := NewWith[int, string](utils.NumberComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	other := NewWith[int, string](utils.NumberComparator[int], utils.StringComparator)
+	other.Put(2, "b")
+	other.Put(1, "a")
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Clear()
+	other.Put(1, "a")
+	other.Put(2, "c")
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Put(2, "b")
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapPutStrict(t *testing.T) {
+	m := NewWith(utils.NumberComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	if err := m.PutStrict(3, "a"); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	if err := m.PutStrict(1, "a"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := m.PutStrict(2, "c"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := m.PutStrict(3, "b"); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.ForcePut(4, "a")
+	tests := [][]interface{}{
+		{1, "", false},
+		{2, "c", true},
+		{3, "b", true},
+		{4, "a", true},
+	}
+	for _, test := range tests {
+		actualValue, actualFound := m.Get(test[0].(int))
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+}
+
+func TestMapInverse(t *testing.T) {
+	m := NewWith(utils.NumberComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	inverse := m.Inverse()
+	if actualValue, found := inverse.Get("b"); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := inverse.GetKey(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	inverse.Put("c", 3)
+	if actualValue, found := m.Get(3); actualValue != "c" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	if err := inverse.PutStrict("d", 4); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := inverse.PutStrict("e", 2); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	inverse.ForcePut("b", 1)
+	inverse.Remove("c")
+	inverse.Remove("d")
+	inverse.Remove("x")
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", inverse.Keys()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", inverse.Values()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := inverse.String(), "InverseBidiMap\nmap[b:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(5, "e")
+	if actualValue, expectedValue := inverse.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := inverse.Inverse(); actualValue != maps.BidiMap[int, string](m) {
+		t.Errorf("Got %v expected %v", actualValue, m)
+	}
+	if actualValue := maps.NewInverseView(inverse); actualValue != maps.BidiMap[int, string](m) {
+		t.Errorf("Got %v expected %v", actualValue, m)
+	}
+	inverse.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := inverse.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapInversePutStrictComparator(t *testing.T) {
+	caseInsensitive := func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) }
+	m := NewWith(utils.NumberComparator[int], caseInsensitive)
+	m.Put(1, "a")
+	inverse := m.Inverse()
+	if err := inverse.PutStrict("A", 1); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := m.Get(1); actualValue != "A" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "A")
+	}
+	if err := inverse.PutStrict("b", 1); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	m.Put(2, "b")
+	if err := inverse.PutStrict("B", 1); err != maps.ErrValueExists {
+		t.Errorf("Got %v expected %v", err, maps.ErrValueExists)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Values()), "[A b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapByValue(t *testing.T) {
+	m := NewWith(utils.StringComparator, utils.NumberComparator[int])
+	m.Put("c", 30)
+	m.Put("a", 20)
+	m.Put("b", 10)
+	tests := [][]interface{}{
+		{5, "", 0, false, "b", 10, true},
+		{10, "b", 10, true, "b", 10, true},
+		{15, "b", 10, true, "a", 20, true},
+		{30, "c", 30, true, "c", 30, true},
+		{35, "c", 30, true, "", 0, false},
+	}
+	for _, test := range tests {
+		key, value, found := m.FloorByValue(test[0].(int))
+		if key != test[1] || value != test[2] || found != test[3] {
+			t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, test[1], test[2], test[3])
+		}
+		key, value, found = m.CeilingByValue(test[0].(int))
+		if key != test[4] || value != test[5] || found != test[6] {
+			t.Errorf("Got %v %v %v expected %v %v %v", key, value, found, test[4], test[5], test[6])
+		}
+	}
+
+	it := m.ValueIterator()
+	var pairs []string
+	for it.Next() {
+		pairs = append(pairs, fmt.Sprintf("%v:%v", it.Key(), it.Value()))
+	}
+	if actualValue, expectedValue := strings.Join(pairs, " "), "b:10 a:20 c:30"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := it.Last(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Key(), "c"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := it.PrevTo(func(key string, value int) bool { return key == "b" }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Value(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Inverse().Keys()), "[10 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	m := NewWith[int, string](utils.NumberComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	it := m.Iterator()
+	it.Next()
+	m.Put(4, "d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Key() })
+
+	it.Begin()
+	m.Remove(1) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	assertConcurrentModification(func() { it.Next() })
+
+	valueIterator := m.ValueIterator()
+	m.Put(5, "e")
+	valueIterator.Next() // an iterator before the first element is not affected
+	m.Put(6, "f")
+	assertConcurrentModification(func() { valueIterator.Next() })
+}
+
+func TestMapIteratorRemove(t *testing.T) {
+	m := NewWith(utils.NumberComparator[int], utils.NumberComparator[int])
+	for i := 1; i <= 20; i++ {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []int
+	for it.Next() {
+		visited = append(visited, it.Key())
+		if it.Key()%2 == 0 {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 3 5 7 9 11 13 15 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[1 3 5 7 9 11 13 15 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.GetKey(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	visited = nil
+	it.End()
+	for it.Prev() {
+		visited = append(visited, it.Key())
+		if it.Key()%3 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[19 17 15 13 11 9 7 5 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 5 7 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// changing direction after a removal
+	it.First()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	values := m.ValueIterator()
+	for values.Next() {
+		if values.Value() > 12 {
+			values.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 11]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.GetKey(13); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestMapIteratorSet(t *testing.T) {
+	m := NewWith(utils.NumberComparator[int], utils.StringComparator)
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	it := m.Iterator()
+	if ok, err := it.Set("x"); ok || err != nil {
+		t.Errorf("Got %v,%v expected %v,%v", ok, err, false, nil)
+	}
+	it.Next()
+	if ok, err := it.Set("z"); !ok || err != nil {
+		t.Errorf("Got %v,%v expected %v,%v", ok, err, true, nil)
+	}
+	if ok, err := it.Set("z"); !ok || err != nil { // the value of the current element itself
+		t.Errorf("Got %v,%v expected %v,%v", ok, err, true, nil)
+	}
+	it.Next()
+	if ok, err := it.Set("z"); ok || err != maps.ErrValueExists {
+		t.Errorf("Got %v,%v expected %v,%v", ok, err, false, maps.ErrValueExists)
+	}
+	if ok, err := it.Set("a"); !ok || err != nil { // the value is free again
+		t.Errorf("Got %v,%v expected %v,%v", ok, err, true, nil)
+	}
+	if actualValue, expectedValue := it.Value(), "a"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[a c z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tests := [][]interface{}{
+		{"a", 2, true},
+		{"b", 0, false},
+		{"c", 3, true},
+		{"z", 1, true},
+	}
+	for _, test := range tests {
+		if actualValue, found := m.GetKey(test[0].(string)); actualValue != test[1] || found != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", actualValue, found, test[1], test[2])
+		}
+	}
+	if actualValue, expectedValue := m.String(), "TreeBidiMap\nmap[1:z 2:a 3:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return NewWith(utils.NumberComparator[int], utils.StringComparator) })
+}
+
+func TestMapIteratorConformance(t *testing.T) {
+	containertest.TestIteratorWithKey(t, func(keys []int, values []string) containers.IteratorWithKey[int, string] {
+		m := NewWith(utils.NumberComparator[int], utils.StringComparator)
+		for index, key := range keys {
+			m.Put(key, values[index])
+		}
+		it := m.Iterator()
+		return &it
+	})
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,28 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treemap
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Map[int, int], int] = (*Map[int, int])(nil)
+
+// Clone returns a copy of the map holding the same key-value pairs.
+// The underlying tree is copied directly in O(n) without any rebalancing.
+func (m *Map[K, T]) Clone() *Map[K, T] {
+	return &Map[K, T]{tree: m.tree.Clone()}
+}
+
+// DeepClone returns a copy of the map where every value is passed through the given copy function.
+// Keys are copied by assignment.
+func (m *Map[K, T]) DeepClone(copyValue func(value T) T) *Map[K, T] {
+	return &Map[K, T]{tree: m.tree.DeepClone(copyValue)}
+}
+
+// Equal returns true if both maps hold the same key-value pairs.
+// Keys and values are compared with utils.DefaultEquality.
+func (m *Map[K, T]) Equal(other *Map[K, T]) bool {
+	return m.tree.Equal(other.tree)
+}
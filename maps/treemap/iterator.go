@@ -102,3 +102,18 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the map and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing through the iterator is the only modification of the map that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Remove() bool {
+	return iterator.iterator.Remove()
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Set(value T) bool {
+	return iterator.iterator.Set(value)
+}
@@ -109,41 +109,133 @@ func (m *Map[K, T]) Max() (key K, value T) {
 }
 
 // Floor finds the floor key-value pair for the input key.
-// In case that no floor is found, then both returned values will be nil.
-// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+// Third return parameter is true if floor was found, otherwise false.
 //
 // Floor key is defined as the largest key that is smaller than or equal to the given key.
 // A floor key may not be found, either because the map is empty, or because
 // all keys in the map are larger than the given key.
 //
 // Key should adhere to the comparator's type assertion, otherwise method panics.
-func (m *Map[K, T]) Floor(key K) (foundKey K, foundValue T) {
-	node, found := m.tree.Floor(key)
-	if found {
-		return node.Key, node.Value
-	}
-	var emptyK K
-	var emptyT T
-	return emptyK, emptyT
+func (m *Map[K, T]) Floor(key K) (foundKey K, foundValue T, found bool) {
+	return entry(m.tree.Floor(key))
 }
 
 // Ceiling finds the ceiling key-value pair for the input key.
-// In case that no ceiling is found, then both returned values will be nil.
-// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+// Third return parameter is true if ceiling was found, otherwise false.
 //
 // Ceiling key is defined as the smallest key that is larger than or equal to the given key.
 // A ceiling key may not be found, either because the map is empty, or because
 // all keys in the map are smaller than the given key.
 //
 // Key should adhere to the comparator's type assertion, otherwise method panics.
-func (m *Map[K, T]) Ceiling(key K) (foundKey K, foundValue T) {
-	node, found := m.tree.Ceiling(key)
-	if found {
-		return node.Key, node.Value
+func (m *Map[K, T]) Ceiling(key K) (foundKey K, foundValue T, found bool) {
+	return entry(m.tree.Ceiling(key))
+}
+
+// Lower finds the lower key-value pair for the input key.
+// Third return parameter is true if lower key was found, otherwise false.
+//
+// Lower key is defined as the largest key that is strictly smaller than the given key.
+// A lower key may not be found, either because the map is empty, or because
+// all keys in the map are larger than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Lower(key K) (foundKey K, foundValue T, found bool) {
+	return entry(m.tree.Lower(key))
+}
+
+// Higher finds the higher key-value pair for the input key.
+// Third return parameter is true if higher key was found, otherwise false.
+//
+// Higher key is defined as the smallest key that is strictly larger than the given key.
+// A higher key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than or equal to the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map[K, T]) Higher(key K) (foundKey K, foundValue T, found bool) {
+	return entry(m.tree.Higher(key))
+}
+
+// FirstEntry returns the minimum key and its value from the tree map.
+// Third return parameter is true if the map is not empty, otherwise false.
+func (m *Map[K, T]) FirstEntry() (key K, value T, found bool) {
+	node := m.tree.Left()
+	return entry(node, node != nil)
+}
+
+// LastEntry returns the maximum key and its value from the tree map.
+// Third return parameter is true if the map is not empty, otherwise false.
+func (m *Map[K, T]) LastEntry() (key K, value T, found bool) {
+	node := m.tree.Right()
+	return entry(node, node != nil)
+}
+
+// PollFirst removes the minimum key from the tree map and returns it and its value.
+// Third return parameter is true if the map was not empty, otherwise false.
+func (m *Map[K, T]) PollFirst() (key K, value T, found bool) {
+	if key, value, found = m.FirstEntry(); found {
+		m.tree.Remove(key)
 	}
-	var emptyK K
-	var emptyT T
-	return emptyK, emptyT
+	return key, value, found
+}
+
+// PollLast removes the maximum key from the tree map and returns it and its value.
+// Third return parameter is true if the map was not empty, otherwise false.
+func (m *Map[K, T]) PollLast() (key K, value T, found bool) {
+	if key, value, found = m.LastEntry(); found {
+		m.tree.Remove(key)
+	}
+	return key, value, found
+}
+
+// DescendingMap returns a new tree map holding the same elements ordered by the reverse of the map's comparator,
+// i.e. in descending key order. The maps do not share their elements, later changes to either are not reflected
+// in the other.
+func (m *Map[K, T]) DescendingMap() *Map[K, T] {
+	descending := NewWith[K, T](utils.Reverse(m.tree.Comparator))
+	it := m.Iterator()
+	for it.End(); it.Prev(); {
+		descending.Put(it.Key(), it.Value())
+	}
+	return descending
+}
+
+// DescendingKeys returns all keys in reverse order.
+func (m *Map[K, T]) DescendingKeys() []K {
+	keys := make([]K, 0, m.Size())
+	it := m.Iterator()
+	for it.End(); it.Prev(); {
+		keys = append(keys, it.Key())
+	}
+	return keys
+}
+
+// Split moves the elements with keys smaller than the given key to the left map and the other elements to the
+// right map in O(log n) and leaves the map empty. Both maps use the comparator of the map.
+func (m *Map[K, T]) Split(key K) (left *Map[K, T], right *Map[K, T]) {
+	leftTree, rightTree := m.tree.Split(key)
+	return &Map[K, T]{tree: leftTree}, &Map[K, T]{tree: rightTree}
+}
+
+// Union moves the elements of another map into the map and leaves another map empty.
+// The map keeps its values of the keys found in both maps. Both maps must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger map.
+func (m *Map[K, T]) Union(another *Map[K, T]) {
+	m.tree.Union(another.tree)
+}
+
+// Intersection removes the elements whose keys are not in another map from the map and leaves another map empty.
+// Both maps must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger map.
+func (m *Map[K, T]) Intersection(another *Map[K, T]) {
+	m.tree.Intersection(another.tree)
+}
+
+// Difference removes the elements whose keys are in another map from the map and leaves another map empty.
+// Both maps must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger map.
+func (m *Map[K, T]) Difference(another *Map[K, T]) {
+	m.tree.Difference(another.tree)
 }
 
 // String returns a string representation of container
@@ -156,3 +248,11 @@ func (m *Map[K, T]) String() string {
 	return strings.TrimRight(str, " ") + "]"
 
 }
+
+// entry returns the key and value of the node if found, otherwise zero values.
+func entry[K comparable, T any](node *rbt.Node[K, T], found bool) (key K, value T, ok bool) {
+	if !found {
+		return key, value, false
+	}
+	return node.Key, node.Value, true
+}
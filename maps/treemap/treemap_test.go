@@ -7,7 +7,12 @@ package treemap
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/maps"
+	"github.com/ugurcsen/gods-generic/maps/sortedslicemap"
 	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -214,8 +219,7 @@ func TestMapFloor(t *testing.T) {
 
 	for _, test := range tests1 {
 		// retrievals
-		actualKey, actualValue := m.Floor(test[0].(int))
-		actualFound := actualKey != emptyK && actualValue != emptyT
+		actualKey, actualValue, actualFound := m.Floor(test[0].(int))
 		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
 			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
 		}
@@ -245,14 +249,186 @@ func TestMapCeiling(t *testing.T) {
 
 	for _, test := range tests1 {
 		// retrievals
-		actualKey, actualValue := m.Ceiling(test[0].(int))
-		actualFound := actualKey != emptyK && actualValue != emptyT
+		actualKey, actualValue, actualFound := m.Ceiling(test[0].(int))
 		if actualKey != test[1] || actualValue != test[2] || actualFound != test[3] {
 			t.Errorf("Got %v, %v, %v, expected %v, %v, %v", actualKey, actualValue, actualFound, test[1], test[2], test[3])
 		}
 	}
 }
 
+func TestMapLowerAndHigher(t *testing.T) {
+	m := NewWithNumberComparator[string]()
+	if k, v, found := m.Lower(1); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	if k, v, found := m.Higher(1); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(0, "")
+
+	// key,expectedLowerKey,expectedLowerFound,expectedHigherKey,expectedHigherFound
+	tests := [][]interface{}{
+		{-1, 0, false, 0, true},
+		{0, 0, false, 3, true},
+		{1, 0, true, 3, true},
+		{3, 0, true, 7, true},
+		{4, 3, true, 7, true},
+		{7, 3, true, 0, false},
+		{8, 7, true, 0, false},
+	}
+	for _, test := range tests {
+		if actualKey, _, actualFound := m.Lower(test[0].(int)); actualKey != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v, %v, expected %v, %v for lower of %v", actualKey, actualFound, test[1], test[2], test[0])
+		}
+		if actualKey, _, actualFound := m.Higher(test[0].(int)); actualKey != test[3] || actualFound != test[4] {
+			t.Errorf("Got %v, %v, expected %v, %v for higher of %v", actualKey, actualFound, test[3], test[4], test[0])
+		}
+	}
+	if actualValue, _, actualFound := m.Floor(0); actualValue != 0 || !actualFound {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, actualFound, 0, true)
+	}
+}
+
+func TestMapFirstAndLastEntry(t *testing.T) {
+	m := NewWithNumberComparator[string]()
+	if k, v, found := m.FirstEntry(); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	if k, v, found := m.LastEntry(); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	m.Put(5, "e")
+	m.Put(0, "z")
+	m.Put(9, "i")
+	if k, v, found := m.FirstEntry(); k != 0 || v != "z" || !found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "z", true)
+	}
+	if k, v, found := m.LastEntry(); k != 9 || v != "i" || !found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 9, "i", true)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapPollFirstAndLast(t *testing.T) {
+	m := NewWithNumberComparator[string]()
+	if k, v, found := m.PollFirst(); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	if k, v, found := m.PollLast(); k != 0 || v != "" || found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 0, "", false)
+	}
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	if k, v, found := m.PollFirst(); k != 1 || v != "a" || !found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 1, "a", true)
+	}
+	if k, v, found := m.PollLast(); k != 3 || v != "c" || !found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 3, "c", true)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if k, v, found := m.PollLast(); k != 2 || v != "b" || !found {
+		t.Errorf("Got %v, %v, %v, expected %v, %v, %v", k, v, found, 2, "b", true)
+	}
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMapDescending(t *testing.T) {
+	m := NewWithNumberComparator[string]()
+	if actualValue := m.DescendingKeys(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	if actualValue, expectedValue := fmt.Sprint(m.DescendingKeys()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	descending := m.DescendingMap()
+	if actualValue, expectedValue := fmt.Sprint(descending.Keys()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(descending.Values()), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if k, _, found := descending.Floor(0); k != 1 || !found {
+		t.Errorf("Got %v, %v, expected %v, %v", k, found, 1, true)
+	}
+	if k, _, found := descending.Higher(2); k != 1 || !found {
+		t.Errorf("Got %v, %v, expected %v, %v", k, found, 1, true)
+	}
+	descending.Put(4, "d")
+	if actualValue, expectedValue := fmt.Sprint(descending.Keys()), "[4 3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(descending.DescendingMap().Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapSplit(t *testing.T) {
+	m := NewWithNumberComparator[string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	left, right := m.Split(3)
+	if actualValue, expectedValue := fmt.Sprint(left.Keys(), left.Values()), "[1 2] [a b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(right.Keys(), right.Values()), "[3 4] [c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(left.Size(), right.Size(), m.Size()), "2 2 0"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	left.Put(5, "e")
+	if k, v, found := left.LastEntry(); k != 5 || v != "e" || !found {
+		t.Errorf("Got %v, %v, %v expected %v, %v, %v", k, v, found, 5, "e", true)
+	}
+}
+
+func TestMapSetOperations(t *testing.T) {
+	newMap := func(value string, keys ...int) *Map[int, string] {
+		m := NewWithNumberComparator[string]()
+		for _, key := range keys {
+			m.Put(key, value)
+		}
+		return m
+	}
+	tests := []struct {
+		name      string
+		operation func(m, another *Map[int, string])
+		expected  string
+	}{
+		{"union", (*Map[int, string]).Union, "TreeMap\nmap[1:a 2:a 3:a 4:b 5:b]"},
+		{"intersection", (*Map[int, string]).Intersection, "TreeMap\nmap[2:a 3:a]"},
+		{"difference", (*Map[int, string]).Difference, "TreeMap\nmap[1:a]"},
+	}
+	for _, test := range tests {
+		m, another := newMap("a", 1, 2, 3), newMap("b", 2, 3, 4, 5)
+		test.operation(m, another)
+		if actualValue, expectedValue := m.String(), test.expected; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+		}
+		if actualValue, expectedValue := another.Empty(), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+		}
+	}
+}
+
 func sameElements(a []interface{}, b []interface{}) bool {
 	if len(a) != len(b) {
 		return false
@@ -756,6 +932,196 @@ func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
 	}
 }
 
+func TestMapClone(t *testing.T) {
+	m := NewWithNumberComparator[[]int]()
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	m.Put(3, []int{3})
+
+	clone := m.Clone()
+	if actualValue := clone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove(1)
+	clone.Put(4, []int{4})
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	deepClone := m.DeepClone(func(value []int) []int { return append([]int(nil), value...) })
+	if actualValue := deepClone.Equal(m); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	value, _ := deepClone.Get(2)
+	value[0] = 20
+	if actualValue, _ := m.Get(2); actualValue[0] != 2 {
+		t.Errorf("Got %v expected %v", actualValue[0], 2)
+	}
+	if actualValue := deepClone.Equal(m); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	shallowClone := m.Clone()
+	value, _ = shallowClone.Get(3)
+	value[0] = 30
+	if actualValue, _ := m.Get(3); actualValue[0] != 30 {
+		t.Errorf("Got %v expected %v", actualValue[0], 30)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	m := NewWithNumberComparator[[]int]()
+	m.Put(1, []int{1})
+	m.Put(2, []int{2})
+	other := NewWithNumberComparator[[]int]()
+	other.Put(2, []int{2})
+	other.Put(1, []int{1})
+	if actualValue := m.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(3, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Remove(3)
+	other.Put(2, []int{3})
+	if actualValue := m.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestMapIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	m := NewWithNumberComparator[string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+	it := m.Iterator()
+	it.Next()
+	m.Put(1, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Put(4, "d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Key() })
+
+	it.Begin()
+	m.Remove(1) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	m.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestMapIteratorRemove(t *testing.T) {
+	m := NewWithNumberComparator[int]()
+	for i := 1; i <= 20; i++ {
+		m.Put(i, i)
+	}
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []int
+	for it.Next() {
+		visited = append(visited, it.Key())
+		if it.Key()%2 == 0 {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		} else if actualValue, expectedValue := it.Set(it.Value()*10), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 3 5 7 9 11 13 15 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[10 30 50 70 90 110 130 150 170 190]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, found := m.Get(4); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	visited = nil
+	it.End()
+	for it.Prev() {
+		visited = append(visited, it.Key())
+		if it.Key()%3 == 0 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[19 17 15 13 11 9 7 5 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 5 7 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// changing direction after a removal
+	it.First()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Next()
+	it.Next()
+	it.Remove()
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Key(), 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[5 11 13 17 19]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapConformance(t *testing.T) {
+	containertest.TestMap(t, func() maps.Map[int, string] { return NewWithNumberComparator[string]() })
+}
+
+func TestMapIteratorConformance(t *testing.T) {
+	containertest.TestIteratorWithKey(t, func(keys []int, values []string) containers.IteratorWithKey[int, string] {
+		m := NewWithNumberComparator[string]()
+		for index, key := range keys {
+			m.Put(key, values[index])
+		}
+		it := m.Iterator()
+		return &it
+	})
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, struct{}], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -908,3 +1274,53 @@ func BenchmarkTreeMapRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, m, size)
 }
+
+// BenchmarkTreeMapVsSortedSliceMap compares the tree map with the sorted slice map on the same operations,
+// going through the maps.Map interface for both and visiting the keys in random order.
+func BenchmarkTreeMapVsSortedSliceMap(b *testing.B) {
+	implementations := []struct {
+		name string
+		new  func() maps.Map[int, struct{}]
+	}{
+		{"TreeMap", func() maps.Map[int, struct{}] { return NewWithNumberComparator[struct{}]() }},
+		{"SortedSliceMap", func() maps.Map[int, struct{}] { return sortedslicemap.NewWithNumberComparator[struct{}]() }},
+	}
+	for _, size := range []int{10, 100, 1000} {
+		order := rand.New(rand.NewSource(1)).Perm(size)
+		for _, implementation := range implementations {
+			b.Run(fmt.Sprintf("Get/%s/%d", implementation.name, size), func(b *testing.B) {
+				m := implementation.new()
+				for _, n := range order {
+					m.Put(n, struct{}{})
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					for _, n := range order {
+						m.Get(n)
+					}
+				}
+			})
+			b.Run(fmt.Sprintf("Put/%s/%d", implementation.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					m := implementation.new()
+					for _, n := range order {
+						m.Put(n, struct{}{})
+					}
+				}
+			})
+			b.Run(fmt.Sprintf("Remove/%s/%d", implementation.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					m := implementation.new()
+					for _, n := range order {
+						m.Put(n, struct{}{})
+					}
+					b.StartTimer()
+					for _, n := range order {
+						m.Remove(n)
+					}
+				}
+			})
+		}
+	}
+}
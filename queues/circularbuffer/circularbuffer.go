@@ -22,18 +22,19 @@ import (
 var _ queues.Queue[int] = (*Queue[int])(nil)
 
 // Queue holds values in a slice.
-type Queue[T comparable] struct {
+type Queue[T any] struct {
 	values  []T
 	start   int
 	end     int
 	full    bool
 	maxSize int
 	size    int
+	version int // incremented on every modification, used by iterators to detect concurrent modification
 }
 
 // New instantiates a new empty queue with the specified size of maximum number of elements that it can hold.
 // This max size of the buffer cannot be changed.
-func New[T comparable](maxSize int) *Queue[T] {
+func New[T any](maxSize int) *Queue[T] {
 	if maxSize < 1 {
 		panic("Invalid maxSize, should be at least 1")
 	}
@@ -57,6 +58,7 @@ func (queue *Queue[T]) Enqueue(value T) {
 	}
 
 	queue.size = queue.calculateSize()
+	queue.version++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
@@ -70,16 +72,15 @@ func (queue *Queue[T]) Dequeue() (value T, ok bool) {
 
 	value, ok = queue.values[queue.start], true
 
-	if value != empty {
-		queue.values[queue.start] = empty
-		queue.start = queue.start + 1
-		if queue.start >= queue.maxSize {
-			queue.start = 0
-		}
-		queue.full = false
+	queue.values[queue.start] = empty
+	queue.start = queue.start + 1
+	if queue.start >= queue.maxSize {
+		queue.start = 0
 	}
+	queue.full = false
 
 	queue.size = queue.size - 1
+	queue.version++
 
 	return
 }
@@ -116,6 +117,7 @@ func (queue *Queue[T]) Clear() {
 	queue.end = 0
 	queue.full = false
 	queue.size = 0
+	queue.version++
 }
 
 // Values returns all elements in the queue (FIFO order).
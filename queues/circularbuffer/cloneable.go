@@ -0,0 +1,46 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package circularbuffer
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Queue[int], int] = (*Queue[int])(nil)
+
+// Clone returns a copy of the queue with the same maximum size holding the same elements in the same order.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	clone := *queue
+	clone.values = make([]T, queue.maxSize, queue.maxSize)
+	copy(clone.values, queue.values)
+	return &clone
+}
+
+// DeepClone returns a copy of the queue where every element is passed through the given copy function.
+func (queue *Queue[T]) DeepClone(copyValue func(value T) T) *Queue[T] {
+	clone := queue.Clone()
+	for i := 0; i < queue.size; i++ {
+		index := (queue.start + i) % queue.maxSize
+		clone.values[index] = copyValue(queue.values[index])
+	}
+	return clone
+}
+
+// Equal returns true if both queues hold equal elements in the same order.
+// The maximum sizes of the queues are not compared and elements are compared with utils.DefaultEquality.
+func (queue *Queue[T]) Equal(other *Queue[T]) bool {
+	if queue.size != other.size {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for i := 0; i < queue.size; i++ {
+		if !equal(queue.values[(queue.start+i)%queue.maxSize], other.values[(other.start+i)%other.maxSize]) {
+			return false
+		}
+	}
+	return true
+}
@@ -10,14 +10,16 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
-type Iterator[T comparable] struct {
-	queue *Queue[T]
-	index int
+type Iterator[T any] struct {
+	queue   *Queue[T]
+	index   int
+	version int
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the queue is modified during iteration.
 func (queue *Queue[T]) Iterator() Iterator[T] {
-	return Iterator[T]{queue: queue, index: -1}
+	return Iterator[T]{queue: queue, index: -1, version: queue.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +27,10 @@ func (queue *Queue[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.queue.version
+	}
+	iterator.checkVersion()
 	if iterator.index < iterator.queue.size {
 		iterator.index++
 	}
@@ -35,6 +41,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -44,6 +51,7 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
 	index := (iterator.index + iterator.queue.start) % iterator.queue.maxSize
 	value := iterator.queue.values[index]
 	return value
@@ -59,12 +67,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.version = iterator.queue.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.queue.size
+	iterator.version = iterator.queue.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -110,3 +120,10 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkVersion panics if the queue was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.queue.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -13,16 +13,18 @@ import (
 var _ containers.JSONSerializer = (*Queue[int])(nil)
 var _ containers.JSONDeserializer = (*Queue[int])(nil)
 
-// ToJSON outputs the JSON representation of queue's elements.
+// ToJSON outputs the JSON representation of queue's elements in FIFO order.
 func (queue *Queue[T]) ToJSON() ([]byte, error) {
-	return json.Marshal(queue.values[:queue.maxSize])
+	return json.Marshal(queue.Values())
 }
 
 // FromJSON populates list's elements from the input JSON representation.
+// Only the last maxSize elements are kept if the input holds more.
 func (queue *Queue[T]) FromJSON(data []byte) error {
 	var values []T
 	err := json.Unmarshal(data, &values)
 	if err == nil {
+		queue.Clear()
 		for _, value := range values {
 			queue.Enqueue(value)
 		}
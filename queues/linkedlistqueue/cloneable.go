@@ -0,0 +1,25 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedlistqueue
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Queue[int], int] = (*Queue[int])(nil)
+
+// Clone returns a copy of the queue holding the same elements in the same order.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return &Queue[T]{list: queue.list.Clone()}
+}
+
+// DeepClone returns a copy of the queue where every element is passed through the given copy function.
+func (queue *Queue[T]) DeepClone(copyValue func(value T) T) *Queue[T] {
+	return &Queue[T]{list: queue.list.DeepClone(copyValue)}
+}
+
+// Equal returns true if both queues hold equal elements in the same order.
+func (queue *Queue[T]) Equal(other *Queue[T]) bool {
+	return queue.list.Equal(other.list)
+}
@@ -21,18 +21,20 @@ import (
 var _ queues.Queue[int] = (*Queue[int])(nil)
 
 // Queue holds elements in a singly-linked-list
-type Queue[T comparable] struct {
-	list *singlylinkedlist.List[T]
+type Queue[T any] struct {
+	list    *singlylinkedlist.List[T]
+	version int // incremented on every modification, used by iterators to detect concurrent modification
 }
 
 // New instantiates a new empty queue
-func New[T comparable]() *Queue[T] {
+func New[T any]() *Queue[T] {
 	return &Queue[T]{list: &singlylinkedlist.List[T]{}}
 }
 
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
 	queue.list.Add(value)
+	queue.version++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
@@ -41,6 +43,7 @@ func (queue *Queue[T]) Dequeue() (value T, ok bool) {
 	value, ok = queue.list.Get(0)
 	if ok {
 		queue.list.Remove(0)
+		queue.version++
 	}
 	return
 }
@@ -64,6 +67,7 @@ func (queue *Queue[T]) Size() int {
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
 	queue.list.Clear()
+	queue.version++
 }
 
 // Values returns all elements in the queue (FIFO order).
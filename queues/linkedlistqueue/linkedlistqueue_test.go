@@ -7,6 +7,9 @@ package linkedlistqueue
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/queues"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -259,6 +262,95 @@ func TestQueueString(t *testing.T) {
 	}
 }
 
+func TestQueueClone(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	clone := queue.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(clone.Values())...), "123"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Dequeue()
+	clone.Enqueue(4)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(queue.Values())...), "123"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := queue.DeepClone(func(value int) int { return value * 10 })
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Values())...), "102030"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueEqual(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	if actualValue := queue.Equal(queue.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other := New[int]()
+	other.Enqueue(2)
+	other.Enqueue(1)
+	if actualValue := queue.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Clear()
+	if actualValue := queue.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[int]().Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueNonComparable(t *testing.T) {
+	queue := New[map[string]int]()
+	queue.Enqueue(map[string]int{"a": 1})
+	queue.Enqueue(map[string]int{"b": 2})
+	if actualValue := queue.Equal(queue.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue["a"] != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "map[a:1]")
+	}
+}
+
+func TestQueueIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	queue.Dequeue() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	queue.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestQueueConformance(t *testing.T) {
+	containertest.TestQueue(t, func() queues.Queue[int] { return New[int]() })
+}
+
 func benchmarkEnqueue(b *testing.B, queue *Queue[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,41 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package priorityqueue
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Queue[int], int] = (*Queue[int])(nil)
+
+// Clone returns a copy of the queue backed by the same kind of heap.
+func (queue *Queue[T]) Clone() *Queue[T] {
+	return queue.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the queue where every element is passed through the given copy function.
+// The copy function must not change the ordering of the elements with respect to the queue's comparator.
+func (queue *Queue[T]) DeepClone(copyValue func(value T) T) *Queue[T] {
+	clone := NewWithHeap(queue.Comparator, queue.newHeap)
+	values := queue.heap.Values()
+	for index, value := range values {
+		values[index] = copyValue(value)
+	}
+	clone.heap.Push(values...)
+	return clone
+}
+
+// Equal returns true if both queues hold equal elements, i.e. they would dequeue the same elements in the same order,
+// apart from the order of elements the comparator considers equal.
+// Complexity is O(n log n), since the elements are sorted with the queue's comparator before comparing.
+// Elements are compared with utils.DefaultEquality.
+func (queue *Queue[T]) Equal(other *Queue[T]) bool {
+	if queue.Size() != other.Size() {
+		return false
+	}
+	return utils.SameElements(queue.heap.Values(), other.heap.Values(), queue.Comparator, utils.DefaultEquality[T]())
+}
@@ -6,20 +6,24 @@ package priorityqueue
 
 import (
 	"github.com/ugurcsen/gods-generic/containers"
-	"github.com/ugurcsen/gods-generic/trees/binaryheap"
 )
 
 // Assert Iterator implementation
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
-type Iterator[T comparable] struct {
-	iterator binaryheap.Iterator[T]
+type Iterator[T any] struct {
+	queue   *Queue[T]
+	index   int
+	values  []T // values of the queue, fetched once per iteration
+	version int
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// Values are iterated in the order of Values().
+// The iterator panics with containers.ErrConcurrentModification if the queue is modified during iteration.
 func (queue *Queue[T]) Iterator() Iterator[T] {
-	return Iterator[T]{iterator: queue.heap.Iterator()}
+	return Iterator[T]{queue: queue, index: -1, version: queue.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,52 +31,75 @@ func (queue *Queue[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
-	return iterator.iterator.Next()
+	if iterator.index == -1 {
+		iterator.sync()
+	}
+	iterator.checkVersion()
+	if iterator.index < iterator.queue.Size() {
+		iterator.index++
+	}
+	return iterator.withinRange()
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
-	return iterator.iterator.Prev()
+	iterator.checkVersion()
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.withinRange()
 }
 
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	return iterator.iterator.Value()
+	iterator.checkVersion()
+	if !iterator.withinRange() {
+		var empty T
+		return empty
+	}
+	if iterator.values == nil {
+		iterator.values = iterator.queue.Values()
+	}
+	return iterator.values[iterator.index]
 }
 
 // Index returns the current element's index.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Index() int {
-	return iterator.iterator.Index()
+	return iterator.index
 }
 
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
-	iterator.iterator.Begin()
+	iterator.index = -1
+	iterator.sync()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
-	iterator.iterator.End()
+	iterator.index = iterator.queue.Size()
+	iterator.sync()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
 // If First() returns true, then first element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) First() bool {
-	return iterator.iterator.First()
+	iterator.Begin()
+	return iterator.Next()
 }
 
 // Last moves the iterator to the last element and returns true if there was a last element in the container.
 // If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Last() bool {
-	return iterator.iterator.Last()
+	iterator.End()
+	return iterator.Prev()
 }
 
 // NextTo moves the iterator to the next element from current position that satisfies the condition given by the
@@ -80,7 +107,13 @@ func (iterator *Iterator[T]) Last() bool {
 // If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.NextTo(f)
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
 }
 
 // PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
@@ -88,5 +121,32 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 // If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.PrevTo(f)
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check that the index is within bounds of the queue
+func (iterator *Iterator[T]) withinRange() bool {
+	return iterator.index >= 0 && iterator.index < iterator.queue.Size()
+}
+
+// sync binds the iterator to the current state of the queue, dropping the values fetched for an older state.
+func (iterator *Iterator[T]) sync() {
+	if iterator.version != iterator.queue.version {
+		iterator.values = nil
+		iterator.version = iterator.queue.version
+	}
+}
+
+// checkVersion panics if the queue was modified since the iterator was created or reset.
+
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.queue.version {
+		panic(containers.ErrConcurrentModification)
+	}
 }
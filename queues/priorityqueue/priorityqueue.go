@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package priorityqueue implements a priority queue backed by binary queue.
+// Package priorityqueue implements a priority queue backed by a binary heap (default), pairing heap or Fibonacci heap.
 //
 // An unbounded priority queue based on a priority queue.
 // The elements of the priority queue are ordered by a comparator provided at queue construction time.
@@ -18,7 +18,10 @@ package priorityqueue
 import (
 	"fmt"
 	"github.com/ugurcsen/gods-generic/queues"
+	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/trees/binaryheap"
+	"github.com/ugurcsen/gods-generic/trees/fibonacciheap"
+	"github.com/ugurcsen/gods-generic/trees/pairingheap"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 )
@@ -26,26 +29,71 @@ import (
 // Assert Queue implementation
 var _ queues.Queue[int] = (*Queue[int])(nil)
 
-// Queue holds elements in an array-list
-type Queue[T comparable] struct {
-	heap       *binaryheap.Heap[T]
+// Queue holds elements in a heap
+type Queue[T any] struct {
+	heap       trees.Heap[T]
+	newHeap    func(comparator utils.Comparator[T]) trees.Heap[T]
+	version    int // incremented on every modification, used by iterators to detect concurrent modification
 	Comparator utils.Comparator[T]
 }
 
-// NewWith instantiates a new empty queue with the custom comparator.
-func NewWith[T comparable](comparator utils.Comparator[T]) *Queue[T] {
-	return &Queue[T]{heap: binaryheap.NewWith[T](comparator), Comparator: comparator}
+// NewWith instantiates a new empty queue backed by a binary heap with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Queue[T] {
+	return NewWithHeap(comparator, func(comparator utils.Comparator[T]) trees.Heap[T] {
+		return binaryheap.NewWith(comparator)
+	})
+}
+
+// NewWithArity instantiates a new empty queue backed by a d-ary heap with the custom comparator,
+// where each node of the heap has up to arity children.
+func NewWithArity[T any](arity int, comparator utils.Comparator[T]) *Queue[T] {
+	return NewWithHeap(comparator, func(comparator utils.Comparator[T]) trees.Heap[T] {
+		return binaryheap.NewWithArity(arity, comparator)
+	})
+}
+
+// NewFrom instantiates a new queue backed by a binary heap with the custom comparator holding the passed values.
+// The heap is built in O(n) and the passed slice is not modified.
+func NewFrom[T any](values []T, comparator utils.Comparator[T]) *Queue[T] {
+	queue := NewWith(comparator)
+	queue.heap = binaryheap.NewFrom(values, comparator)
+	return queue
+}
+
+// NewWithPairingHeap instantiates a new empty queue backed by a pairing heap with the custom comparator.
+func NewWithPairingHeap[T any](comparator utils.Comparator[T]) *Queue[T] {
+	return NewWithHeap(comparator, func(comparator utils.Comparator[T]) trees.Heap[T] {
+		return pairingheap.NewWith(comparator)
+	})
+}
+
+// NewWithFibonacciHeap instantiates a new empty queue backed by a Fibonacci heap with the custom comparator.
+func NewWithFibonacciHeap[T any](comparator utils.Comparator[T]) *Queue[T] {
+	return NewWithHeap(comparator, func(comparator utils.Comparator[T]) trees.Heap[T] {
+		return fibonacciheap.NewWith(comparator)
+	})
+}
+
+// NewWithHeap instantiates a new empty queue with the custom comparator,
+// backed by the heap returned by the given function for that comparator.
+// The function is also used to create the heaps of copies of the queue.
+func NewWithHeap[T any](comparator utils.Comparator[T], newHeap func(comparator utils.Comparator[T]) trees.Heap[T]) *Queue[T] {
+	return &Queue[T]{heap: newHeap(comparator), newHeap: newHeap, Comparator: comparator}
 }
 
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
 	queue.heap.Push(value)
+	queue.version++
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
 // Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
 func (queue *Queue[T]) Dequeue() (value T, ok bool) {
-	return queue.heap.Pop()
+	if value, ok = queue.heap.Pop(); ok {
+		queue.version++
+	}
+	return
 }
 
 // Peek returns top element on the queue without removing it, or nil if queue is empty.
@@ -67,6 +115,7 @@ func (queue *Queue[T]) Size() int {
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
 	queue.heap.Clear()
+	queue.version++
 }
 
 // Values returns all elements in the queue.
@@ -7,6 +7,9 @@ package priorityqueue
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/queues"
 	"github.com/ugurcsen/gods-generic/utils"
 	"math/rand"
 	"strings"
@@ -475,6 +478,115 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBinaryQueueClone(t *testing.T) {
+	queue := NewWith[Element](byPriority)
+	a := Element{name: "a", priority: 1}
+	b := Element{name: "b", priority: 2}
+	c := Element{name: "c", priority: 3}
+	queue.Enqueue(a)
+	queue.Enqueue(c)
+	queue.Enqueue(b)
+
+	clone := queue.Clone()
+	if actualValue := clone.Equal(queue); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for _, expectedValue := range []Element{c, b, a} {
+		if actualValue, ok := clone.Dequeue(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := queue.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := clone.Equal(queue); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	other := NewWith[Element](byPriority)
+	other.Enqueue(b)
+	other.Enqueue(a)
+	other.Enqueue(c)
+	if actualValue := other.Equal(queue); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	deepClone := queue.DeepClone(func(value Element) Element {
+		value.name = strings.ToUpper(value.name)
+		return value
+	})
+	if actualValue, ok := deepClone.Peek(); actualValue.name != "C" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "C")
+	}
+}
+
+func TestQueueWithHeaps(t *testing.T) {
+	newQuaternaryQueue := func(comparator utils.Comparator[int]) *Queue[int] { return NewWithArity(4, comparator) }
+	newQueueFrom := func(comparator utils.Comparator[int]) *Queue[int] { return NewFrom(rand.Perm(100), comparator) }
+	for _, newQueue := range []func(comparator utils.Comparator[int]) *Queue[int]{NewWith[int], NewWithPairingHeap[int], NewWithFibonacciHeap[int], newQuaternaryQueue, newQueueFrom} {
+		queue := newQueue(utils.NumberComparator[int])
+		for i := queue.Size(); i < 1000; i++ {
+			queue.Enqueue(rand.Intn(100))
+		}
+		clone := queue.Clone()
+		if actualValue := clone.Equal(queue); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		it := queue.Iterator()
+		if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		prev, _ := queue.Dequeue()
+		for !queue.Empty() {
+			curr, _ := queue.Dequeue()
+			if prev > curr {
+				t.Errorf("Queue property invalidated. prev: %v current: %v", prev, curr)
+			}
+			prev = curr
+		}
+		if actualValue, expectedValue := clone.Size(), 1000; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestQueueIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	queue := NewWith(utils.NumberComparator[int])
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	it := queue.Iterator()
+	it.Next()
+	queue.Enqueue(0)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	queue.Dequeue() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	queue.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestQueueConformance(t *testing.T) {
+	containertest.TestQueue(t, func() queues.Queue[int] { return NewWith(utils.NumberComparator[int]) })
+}
+
 func benchmarkEnqueue(b *testing.B, queue *Queue[Element], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -19,6 +19,7 @@ func (queue *Queue[T]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the queue from the input JSON representation.
 func (queue *Queue[T]) FromJSON(data []byte) error {
+	queue.version++
 	return queue.heap.FromJSON(data)
 }
 
@@ -0,0 +1,53 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slidingwindow
+
+// monotonicDeque holds the values of the window that can still become its minimum (or maximum), i.e. the values
+// that are not dominated by a newer value. The values are ordered from the oldest to the newest, which makes them
+// monotonic, so that the front value is the minimum (or maximum) of the window.
+type monotonicDeque[T any] struct {
+	entries   []dequeEntry[T]
+	head      int                       // index of the front entry in entries
+	dominates func(newer, older T) bool // true if the older value can never become the extremum again
+}
+
+type dequeEntry[T any] struct {
+	value    T
+	sequence int // sequence number of the value in the window
+}
+
+// push adds the newest value, dropping the values it dominates, in amortized O(1).
+func (deque *monotonicDeque[T]) push(value T, sequence int) {
+	for len(deque.entries) > deque.head && deque.dominates(value, deque.entries[len(deque.entries)-1].value) {
+		deque.entries = deque.entries[:len(deque.entries)-1]
+	}
+	deque.entries = append(deque.entries, dequeEntry[T]{value: value, sequence: sequence})
+}
+
+// evict drops the front value if it is the value with the sequence number, which left the window, in amortized O(1).
+func (deque *monotonicDeque[T]) evict(sequence int) {
+	if deque.head < len(deque.entries) && deque.entries[deque.head].sequence == sequence {
+		deque.entries[deque.head] = dequeEntry[T]{} // cleanup reference
+		deque.head++
+		// compact once the dropped entries make up half of the slice, so that they are moved only once on average
+		if deque.head*2 >= len(deque.entries) {
+			deque.entries = deque.entries[:copy(deque.entries, deque.entries[deque.head:])]
+			deque.head = 0
+		}
+	}
+}
+
+// front returns the minimum (or maximum) of the window.
+func (deque *monotonicDeque[T]) front() (value T, ok bool) {
+	if deque.head == len(deque.entries) {
+		return value, false
+	}
+	return deque.entries[deque.head].value, true
+}
+
+func (deque *monotonicDeque[T]) clear() {
+	deque.entries = nil
+	deque.head = 0
+}
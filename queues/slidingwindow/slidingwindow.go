@@ -0,0 +1,278 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package slidingwindow implements a sliding window over a stream of numbers with running aggregates.
+//
+// The window holds the most recent values in a circular buffer, evicting the oldest value when it is full and,
+// for time-based windows, every value older than the duration of the window. The sum, mean and variance of the
+// values in the window are kept as running totals and their minimum and maximum in monotonic deques, so that
+// adding and evicting values takes amortized O(1) time and all aggregates are available in O(1) time.
+// The running sum of floating point values is compensated for rounding errors, and the running sum of squares is
+// recomputed from the buffer when evicting values cancels most of it, so that a large value passing through the
+// window does not leave its rounding errors behind.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Moving_average
+package slidingwindow
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ugurcsen/gods-generic/queues"
+	"github.com/ugurcsen/gods-generic/queues/circularbuffer"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[int] = (*Window[int])(nil)
+
+// Window holds the values in a circular buffer along with their running aggregates
+type Window[T utils.ComparableNumber] struct {
+	samples  *circularbuffer.Queue[sample[T]]
+	duration time.Duration // zero for windows that are only bounded by size
+	latest   time.Time     // newest timestamp seen
+	minimums monotonicDeque[T]
+	maximums monotonicDeque[T]
+	sequence int // sequence number of the next added value
+	first    int // sequence number of the oldest value in the window
+	sum      T
+	residue  T // compensation of the rounding errors of the sum, always zero for integers
+	mean     float64
+	m2       float64 // sum of squared differences from the mean
+	peak     float64 // largest m2 since it was last recomputed
+}
+
+// cancellation is the factor by which m2 may shrink below its peak before it is recomputed from the buffer,
+// as the rounding errors of the peak would then exceed half of the digits of m2
+const cancellation = 1 << 26
+
+type sample[T any] struct {
+	value     T
+	timestamp time.Time
+}
+
+// New instantiates a new empty window holding at most the given number of most recent values.
+func New[T utils.ComparableNumber](size int) *Window[T] {
+	return NewWithDuration[T](size, 0)
+}
+
+// NewWithDuration instantiates a new empty time-based window holding the values whose timestamps are within the
+// duration before the timestamp of the newest value, at most the given number of most recent values.
+// The window is only bounded by size if the duration is not positive.
+func NewWithDuration[T utils.ComparableNumber](size int, duration time.Duration) *Window[T] {
+	if size < 1 {
+		panic("Invalid size, should be at least 1")
+	}
+	window := &Window[T]{samples: circularbuffer.New[sample[T]](size), duration: max(duration, 0)}
+	window.minimums.dominates = func(newer, older T) bool { return newer <= older }
+	window.maximums.dominates = func(newer, older T) bool { return newer >= older }
+	return window
+}
+
+// Add adds the value to the window, timestamped with the current time, evicting the oldest value if the window is
+// full and, for time-based windows, all values that fell out of the duration of the window.
+func (window *Window[T]) Add(value T) {
+	window.AddAt(time.Now(), value)
+}
+
+// AddAt adds the value to the window with the timestamp, evicting the oldest value if the window is full and,
+// for time-based windows, all values that fell out of the duration of the window.
+// Timestamps are expected in non-decreasing order, an older timestamp than the newest one is treated as the newest.
+func (window *Window[T]) AddAt(timestamp time.Time, value T) {
+	if timestamp.Before(window.latest) {
+		timestamp = window.latest
+	}
+	window.Advance(timestamp)
+	if window.samples.Full() {
+		window.Dequeue()
+	}
+	window.samples.Enqueue(sample[T]{value: value, timestamp: timestamp})
+	window.minimums.push(value, window.sequence)
+	window.maximums.push(value, window.sequence)
+	window.sequence++
+
+	window.add(value)
+	delta := float64(value) - window.mean
+	window.mean += delta / float64(window.samples.Size())
+	window.m2 += delta * (float64(value) - window.mean)
+	window.peak = max(window.peak, window.m2)
+}
+
+// Advance moves the end of a time-based window to the given time, evicting all values whose timestamps are not
+// within the duration before that time, and returns the number of evicted values.
+// Does not do anything for windows that are only bounded by size.
+func (window *Window[T]) Advance(now time.Time) int {
+	if now.After(window.latest) {
+		window.latest = now
+	}
+	if window.duration == 0 {
+		return 0
+	}
+	evicted := 0
+	start := window.latest.Add(-window.duration)
+	for oldest, ok := window.samples.Peek(); ok && !oldest.timestamp.After(start); oldest, ok = window.samples.Peek() {
+		window.Dequeue()
+		evicted++
+	}
+	return evicted
+}
+
+// Enqueue adds the value to the window like Add.
+func (window *Window[T]) Enqueue(value T) {
+	window.Add(value)
+}
+
+// Dequeue evicts the oldest value of the window and returns it, or nil if window is empty.
+// Second return parameter is true, unless the window was empty and there was nothing to dequeue.
+func (window *Window[T]) Dequeue() (value T, ok bool) {
+	oldest, ok := window.samples.Dequeue()
+	if !ok {
+		return value, false
+	}
+	window.minimums.evict(window.first)
+	window.maximums.evict(window.first)
+	window.first++
+
+	window.add(-oldest.value)
+	if size := window.samples.Size(); size == 0 {
+		window.sum, window.residue, window.mean, window.m2, window.peak = 0, 0, 0, 0, 0
+	} else {
+		delta := float64(oldest.value) - window.mean
+		window.mean -= delta / float64(size)
+		window.m2 = max(window.m2-delta*(float64(oldest.value)-window.mean), 0)
+		if window.m2*cancellation < window.peak {
+			window.recompute()
+		}
+	}
+	return oldest.value, true
+}
+
+// Peek returns the oldest value of the window without evicting it, or nil if window is empty.
+// Second return parameter is true, unless the window was empty and there was nothing to peek.
+func (window *Window[T]) Peek() (value T, ok bool) {
+	oldest, ok := window.samples.Peek()
+	return oldest.value, ok
+}
+
+// Sum returns the sum of the values in the window, zero if the window is empty.
+func (window *Window[T]) Sum() T {
+	return window.sum + window.residue
+}
+
+// Mean returns the arithmetic mean of the values in the window, zero if the window is empty.
+func (window *Window[T]) Mean() float64 {
+	if window.samples.Empty() {
+		return 0
+	}
+	return float64(window.Sum()) / float64(window.samples.Size())
+}
+
+// Variance returns the population variance of the values in the window, zero if the window is empty.
+// It is updated with Welford's algorithm, which does not lose precision to large sums of squares.
+func (window *Window[T]) Variance() float64 {
+	if window.samples.Empty() {
+		return 0
+	}
+	return window.m2 / float64(window.samples.Size())
+}
+
+// StdDev returns the population standard deviation of the values in the window, zero if the window is empty.
+func (window *Window[T]) StdDev() float64 {
+	return math.Sqrt(window.Variance())
+}
+
+// Min returns the smallest value in the window, or nil if window is empty.
+// Second return parameter is true, unless the window was empty.
+func (window *Window[T]) Min() (value T, ok bool) {
+	return window.minimums.front()
+}
+
+// Max returns the largest value in the window, or nil if window is empty.
+// Second return parameter is true, unless the window was empty.
+func (window *Window[T]) Max() (value T, ok bool) {
+	return window.maximums.front()
+}
+
+// Empty returns true if window does not contain any values.
+func (window *Window[T]) Empty() bool {
+	return window.samples.Empty()
+}
+
+// Full returns true if the window holds its maximum number of values.
+func (window *Window[T]) Full() bool {
+	return window.samples.Full()
+}
+
+// Size returns number of values within the window.
+func (window *Window[T]) Size() int {
+	return window.samples.Size()
+}
+
+// Clear removes all values from the window.
+func (window *Window[T]) Clear() {
+	window.samples.Clear()
+	window.minimums.clear()
+	window.maximums.clear()
+	window.sum, window.residue, window.mean, window.m2, window.peak = 0, 0, 0, 0, 0
+	window.first = window.sequence
+}
+
+// Values returns all values in the window, from the oldest to the newest.
+func (window *Window[T]) Values() []T {
+	samples := window.samples.Values()
+	values := make([]T, len(samples))
+	for index, sample := range samples {
+		values[index] = sample.value
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (window *Window[T]) String() string {
+	str := "SlidingWindow\n"
+	var values []string
+	for _, value := range window.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// add adds the value to the sum with Neumaier's compensated summation, which keeps the rounding error in the residue.
+// The residue stays zero for integers, whose additions are exact even if they overflow.
+func (window *Window[T]) add(value T) {
+	sum := window.sum + value
+	if abs(window.sum) >= abs(value) {
+		window.residue += (window.sum - sum) + value
+	} else {
+		window.residue += (value - sum) + window.sum
+	}
+	window.sum = sum
+}
+
+// recompute recomputes the sum, mean and m2 from the values in the window.
+func (window *Window[T]) recompute() {
+	values := window.Values()
+	window.sum, window.residue = 0, 0
+	for _, value := range values {
+		window.add(value)
+	}
+	window.mean, window.m2 = window.Mean(), 0
+	for _, value := range values {
+		window.m2 += (float64(value) - window.mean) * (float64(value) - window.mean)
+	}
+	window.peak = window.m2
+}
+
+// abs returns the absolute value, unsigned values are returned as is.
+func abs[T utils.ComparableNumber](value T) T {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
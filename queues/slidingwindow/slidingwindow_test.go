@@ -0,0 +1,261 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package slidingwindow
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/queues"
+)
+
+// assertAggregates compares the aggregates of the window with the aggregates computed from the expected values
+func assertAggregates(t *testing.T, window *Window[int], expected []int) {
+	t.Helper()
+	if actualValue, expectedValue := fmt.Sprint(window.Values()), fmt.Sprint(expected); actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	sum, mean, variance := 0, 0.0, 0.0
+	for _, value := range expected {
+		sum += value
+	}
+	if len(expected) > 0 {
+		mean = float64(sum) / float64(len(expected))
+		for _, value := range expected {
+			variance += (float64(value) - mean) * (float64(value) - mean)
+		}
+		variance /= float64(len(expected))
+	}
+	if actualValue, expectedValue := window.Sum(), sum; actualValue != expectedValue {
+		t.Fatalf("Got sum %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Mean(), mean; math.Abs(actualValue-expectedValue) > 1e-6 {
+		t.Fatalf("Got mean %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Variance(), variance; math.Abs(actualValue-expectedValue) > 1e-6*max(1, variance) {
+		t.Fatalf("Got variance %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.StdDev(), math.Sqrt(variance); math.Abs(actualValue-expectedValue) > 1e-3 {
+		t.Fatalf("Got standard deviation %v expected %v", actualValue, expectedValue)
+	}
+	minimum, maximum := 0, 0
+	if len(expected) > 0 {
+		minimum, maximum = slices.Min(expected), slices.Max(expected)
+	}
+	if actualValue, ok := window.Min(); actualValue != minimum || ok != (len(expected) > 0) {
+		t.Fatalf("Got minimum %v,%v expected %v,%v", actualValue, ok, minimum, len(expected) > 0)
+	}
+	if actualValue, ok := window.Max(); actualValue != maximum || ok != (len(expected) > 0) {
+		t.Fatalf("Got maximum %v,%v expected %v,%v", actualValue, ok, maximum, len(expected) > 0)
+	}
+}
+
+func TestWindowAdd(t *testing.T) {
+	window := New[int](3)
+	assertAggregates(t, window, nil)
+	window.Add(4)
+	assertAggregates(t, window, []int{4})
+	window.Add(2)
+	window.Add(6)
+	assertAggregates(t, window, []int{4, 2, 6})
+	if actualValue, expectedValue := window.Full(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	window.Add(5)
+	assertAggregates(t, window, []int{2, 6, 5})
+	window.Add(1)
+	window.Add(1)
+	assertAggregates(t, window, []int{5, 1, 1})
+	if actualValue, ok := window.Dequeue(); actualValue != 5 || !ok {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 5, true)
+	}
+	assertAggregates(t, window, []int{1, 1})
+	window.Clear()
+	assertAggregates(t, window, nil)
+	window.Add(7)
+	assertAggregates(t, window, []int{7})
+}
+
+func TestWindowRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{1, 2, 5, 64} {
+		window, model := New[int](size), []int{}
+		for i := 0; i < 2000; i++ {
+			if r.Intn(10) == 0 {
+				window.Dequeue()
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			} else {
+				value := r.Intn(1000) - 500
+				window.Add(value)
+				model = append(model, value)
+				if len(model) > size {
+					model = model[1:]
+				}
+			}
+			assertAggregates(t, window, model)
+		}
+	}
+}
+
+func TestWindowFloat(t *testing.T) {
+	window := New[float64](4)
+	for _, value := range []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16, 1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16} {
+		window.Add(value)
+	}
+	// large offsets do not lose the precision of the variance
+	if actualValue, expectedValue := window.Variance(), 22.5; math.Abs(actualValue-expectedValue) > 1e-6 {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Mean(), 1e9+10; math.Abs(actualValue-expectedValue) > 1e-6 {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestWindowFloatMixedMagnitudes(t *testing.T) {
+	window := New[float64](2)
+	window.Add(1e16)
+	window.Add(1)
+	window.Add(1)
+	// the large value does not leave its rounding errors behind
+	if actualValue, expectedValue := window.Sum(), 2.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Mean(), 1.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Variance(), 0.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{1, 2, 5, 64} {
+		window, model := New[float64](size), []float64{}
+		for i := 0; i < 5000; i++ {
+			value := r.Float64() * 10
+			if r.Intn(20) == 0 {
+				value *= math.Pow(10, float64(8+r.Intn(9)))
+			}
+			if r.Intn(2) == 0 {
+				value = -value
+			}
+			window.Add(value)
+			model = append(model, value)
+			if len(model) > size {
+				model = model[1:]
+			}
+			// naive recomputation, whose rounding errors are bounded by the magnitudes of the values in the window
+			sum, magnitude, squares := 0.0, 0.0, 0.0
+			for _, value := range model {
+				sum += value
+				magnitude += math.Abs(value)
+				squares += value * value
+			}
+			mean, variance := sum/float64(len(model)), 0.0
+			for _, value := range model {
+				variance += (value - mean) * (value - mean)
+			}
+			variance /= float64(len(model))
+			if actualValue, expectedValue := window.Sum(), sum; math.Abs(actualValue-expectedValue) > 1e-12*magnitude {
+				t.Fatalf("Got sum %v expected %v for %v", actualValue, expectedValue, model)
+			}
+			if actualValue, expectedValue := window.Mean(), mean; math.Abs(actualValue-expectedValue) > 1e-12*magnitude {
+				t.Fatalf("Got mean %v expected %v for %v", actualValue, expectedValue, model)
+			}
+			if actualValue, expectedValue := window.Variance(), variance; math.Abs(actualValue-expectedValue) > 1e-6*squares/float64(len(model)) {
+				t.Fatalf("Got variance %v expected %v for %v", actualValue, expectedValue, model)
+			}
+		}
+	}
+}
+
+func TestWindowDuration(t *testing.T) {
+	start := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return start.Add(time.Duration(seconds) * time.Second) }
+	window := NewWithDuration[int](4, 10*time.Second)
+	window.AddAt(at(0), 1)
+	window.AddAt(at(3), 2)
+	window.AddAt(at(5), 3)
+	assertAggregates(t, window, []int{1, 2, 3})
+	window.AddAt(at(10), 4) // the value at 0 is exactly the duration old
+	assertAggregates(t, window, []int{2, 3, 4})
+	window.AddAt(at(11), 5)
+	window.AddAt(at(11), 6) // the size bound evicts the oldest value as well
+	assertAggregates(t, window, []int{3, 4, 5, 6})
+	window.AddAt(at(1), 7) // an older timestamp is treated as the newest one
+	assertAggregates(t, window, []int{4, 5, 6, 7})
+	if actualValue, expectedValue := window.Advance(at(20)), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertAggregates(t, window, []int{5, 6, 7})
+	if actualValue, expectedValue := window.Advance(at(15)), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := window.Advance(at(21)), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertAggregates(t, window, nil)
+
+	countWindow := New[int](2)
+	countWindow.AddAt(at(0), 1)
+	if actualValue, expectedValue := countWindow.Advance(at(1000)), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertAggregates(t, countWindow, []int{1})
+}
+
+func TestWindowPeek(t *testing.T) {
+	window := New[int](2)
+	if actualValue, ok := window.Peek(); actualValue != 0 || ok {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 0, false)
+	}
+	window.Enqueue(1)
+	window.Enqueue(2)
+	window.Enqueue(3)
+	if actualValue, ok := window.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, ok, 2, true)
+	}
+}
+
+func TestWindowInvalidSize(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for invalid size")
+		}
+	}()
+	New[int](0)
+}
+
+func TestWindowString(t *testing.T) {
+	window := New[int](3)
+	window.Add(1)
+	window.Add(2)
+	if !strings.HasPrefix(window.String(), "SlidingWindow") {
+		t.Errorf("String should start with container name")
+	}
+	if actualValue, expectedValue := window.String(), "SlidingWindow\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestWindowConformance(t *testing.T) {
+	containertest.TestQueue(t, func() queues.Queue[int] { return New[int](100) })
+}
+
+func BenchmarkWindowAdd100000(b *testing.B) {
+	window := New[int](1000)
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < 100000; n++ {
+			window.Add(n % 997)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package customhashset
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Set[string], string] = (*Set[string])(nil)
+
+// Clone returns a copy of the set holding the same items.
+// The table is copied directly without rehashing any item.
+func (set *Set[T]) Clone() *Set[T] {
+	return &Set[T]{items: set.items.Clone(), hasher: set.hasher}
+}
+
+// DeepClone returns a copy of the set where every item is passed through the given copy function.
+func (set *Set[T]) DeepClone(copyValue func(value T) T) *Set[T] {
+	clone := New[T](set.hasher)
+	for _, item := range set.Values() {
+		clone.Add(copyValue(item))
+	}
+	return clone
+}
+
+// Equal returns true if both sets hold the same items.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	for _, item := range set.Values() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
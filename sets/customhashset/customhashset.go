@@ -0,0 +1,152 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package customhashset implements a set backed by an open-addressing hash table
+// that hashes and compares items with a custom hasher.
+//
+// Unlike the hashset, items do not need to be comparable, e.g. byte slices or strings
+// compared without regard to case can be stored given a suitable utils.Hasher.
+//
+// Structure is not thread safe.
+//
+// References: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package customhashset
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/maps/customhashmap"
+	"github.com/ugurcsen/gods-generic/sets"
+	"github.com/ugurcsen/gods-generic/utils"
+	"strings"
+)
+
+// Assert Set implementation
+var _ sets.Set[string] = (*Set[string])(nil)
+
+// Set holds elements in a custom hash map
+type Set[T any] struct {
+	items  *customhashmap.Map[T, struct{}]
+	hasher utils.Hasher[T]
+}
+
+var itemExists = struct{}{}
+
+// New instantiates a new empty set that hashes and compares items with the given hasher
+// and adds the passed values, if any, to the set
+func New[T any](hasher utils.Hasher[T], values ...T) *Set[T] {
+	set := &Set[T]{items: customhashmap.New[T, struct{}](hasher), hasher: hasher}
+	if len(values) > 0 {
+		set.Add(values...)
+	}
+	return set
+}
+
+// Add adds the items (one or more) to the set.
+func (set *Set[T]) Add(items ...T) {
+	for _, item := range items {
+		set.items.Put(item, itemExists)
+	}
+}
+
+// Remove removes the items (one or more) from the set.
+func (set *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		set.items.Remove(item)
+	}
+}
+
+// Contains check if items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if _, contains := set.items.Get(item); !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) Empty() bool {
+	return set.Size() == 0
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return set.items.Size()
+}
+
+// Clear clears all values in the set.
+func (set *Set[T]) Clear() {
+	set.items.Clear()
+}
+
+// Values returns all items in the set.
+func (set *Set[T]) Values() []T {
+	return set.items.Keys()
+}
+
+// String returns a string representation of container
+func (set *Set[T]) String() string {
+	str := "CustomHashSet\n"
+	items := []string{}
+	for _, item := range set.items.Keys() {
+		items = append(items, fmt.Sprintf("%v", item))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// Intersection returns the intersection between two sets.
+// The new set consists of all elements that are both in "set" and "another".
+// Items are hashed with the hasher of "set".
+// Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
+func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
+	result := New[T](set.hasher)
+
+	// Iterate over smaller set (optimization)
+	if set.Size() <= another.Size() {
+		for _, item := range set.Values() {
+			if another.Contains(item) {
+				result.Add(item)
+			}
+		}
+	} else {
+		for _, item := range another.Values() {
+			if set.Contains(item) {
+				result.Add(item)
+			}
+		}
+	}
+
+	return result
+}
+
+// Union returns the union of two sets.
+// The new set consists of all elements that are in "set" or "another" (possibly both).
+// Items are hashed with the hasher of "set".
+// Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
+func (set *Set[T]) Union(another *Set[T]) *Set[T] {
+	result := New[T](set.hasher)
+	result.Add(set.Values()...)
+	result.Add(another.Values()...)
+	return result
+}
+
+// Difference returns the difference between two sets.
+// The new set consists of all elements that are in "set" but not in "another".
+// Items are hashed with the hasher of "set".
+// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
+func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
+	result := New[T](set.hasher)
+
+	for _, item := range set.Values() {
+		if !another.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashset
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Set[int], int] = (*Set[int])(nil)
+
+// Clone returns a copy of the set holding the same items.
+func (set *Set[T]) Clone() *Set[T] {
+	return set.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the set where every item is passed through the given copy function.
+func (set *Set[T]) DeepClone(copyValue func(value T) T) *Set[T] {
+	clone := &Set[T]{items: make(map[T]struct{}, len(set.items))}
+	for item := range set.items {
+		clone.items[copyValue(item)] = itemExists
+	}
+	return clone
+}
+
+// Equal returns true if both sets hold the same items.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	for item := range set.items {
+		if _, contains := other.items[item]; !contains {
+			return false
+		}
+	}
+	return true
+}
@@ -6,6 +6,8 @@ package hashset
 
 import (
 	"encoding/json"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/sets"
 	"strings"
 	"testing"
 )
@@ -192,6 +194,47 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetClone(t *testing.T) {
+	set := New[string]("a", "b", "c")
+	clone := set.Clone()
+	if actualValue := clone.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	clone.Remove("a")
+	clone.Add("d")
+	if actualValue := set.Contains("a", "b", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Contains("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	deepClone := set.DeepClone(strings.ToUpper)
+	if actualValue := deepClone.Contains("A", "B", "C"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := deepClone.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetEqual(t *testing.T) {
+	set := New[string]("a", "b", "c")
+	if actualValue := set.Equal(New[string]("c", "b", "a")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("a", "b", "d")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(New[string]("a", "b")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetConformance(t *testing.T) {
+	containertest.TestSet(t, func() sets.Set[int] { return New[int]() })
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedhashset
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Set[int], int] = (*Set[int])(nil)
+
+// Clone returns a copy of the set holding the same items in the same insertion-order.
+func (set *Set[T]) Clone() *Set[T] {
+	clone := &Set[T]{
+		table:    make(map[T]struct{}, len(set.table)),
+		ordering: set.ordering.Clone(),
+	}
+	for item := range set.table {
+		clone.table[item] = itemExists
+	}
+	return clone
+}
+
+// DeepClone returns a copy of the set where every item is passed through the given copy function.
+func (set *Set[T]) DeepClone(copyValue func(value T) T) *Set[T] {
+	clone := New[T]()
+	it := set.Iterator()
+	for it.Next() {
+		clone.Add(copyValue(it.Value()))
+	}
+	return clone
+}
+
+// Equal returns true if both sets hold the same items in the same insertion-order.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	return set.ordering.Equal(other.ordering)
+}
@@ -15,11 +15,12 @@ var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 // Iterator holding the iterator's state
 type Iterator[T comparable] struct {
 	iterator doublylinkedlist.Iterator[T]
+	set      *Set[T]
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (set *Set[T]) Iterator() Iterator[T] {
-	return Iterator[T]{iterator: set.ordering.Iterator()}
+	return Iterator[T]{iterator: set.ordering.Iterator(), set: set}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -102,3 +103,18 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the set and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Value() is undefined until then.
+// Removing through the iterator is the only modification of the set that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	element := iterator.iterator.Element()
+	if element == nil {
+		return false
+	}
+	iterator.iterator.Remove()
+	delete(iterator.set.table, element.Value)
+	return true
+}
@@ -7,6 +7,9 @@ package linkedhashset
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/sets"
 	"strings"
 	"testing"
 )
@@ -546,6 +549,122 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetClone(t *testing.T) {
+	set := New[string]("c", "a", "b")
+	clone := set.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", clone.Values()[0], clone.Values()[1], clone.Values()[2]), "cab"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Remove("a")
+	clone.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", set.Values()[0], set.Values()[1], set.Values()[2]), "cab"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.Contains("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	deepClone := set.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", deepClone.Values()[0], deepClone.Values()[1], deepClone.Values()[2]), "CAB"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := deepClone.Contains("A", "B", "C"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetEqual(t *testing.T) {
+	set := New[string]("c", "a", "b")
+	if actualValue := set.Equal(set.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(New[string]("a", "b", "c")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Equal(New[string]("c", "a")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	set := New[int](1, 2, 3)
+	it := set.Iterator()
+	it.Next()
+	set.Add(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	set.Remove(1) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestSetIteratorRemove(t *testing.T) {
+	set := New(1, 2, 3, 4, 5, 6)
+	it := set.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []string
+	for it.Next() {
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+		if it.Value()%2 == 0 {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[0:1 1:2 1:3 2:4 2:5 3:6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Contains(2), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	visited = nil
+	it.End()
+	for it.Prev() {
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+		if it.Value() == 3 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[2:5 1:3 0:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add(3)
+	if actualValue, expectedValue := set.Contains(3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetConformance(t *testing.T) {
+	containertest.TestSet(t, func() sets.Set[int] { return New[int]() })
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
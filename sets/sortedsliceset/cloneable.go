@@ -0,0 +1,38 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sortedsliceset
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+	"slices"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Set[int], int] = (*Set[int])(nil)
+
+// Clone returns a copy of the set holding the same items.
+// The underlying slice is copied directly in O(n) without any searching.
+func (set *Set[T]) Clone() *Set[T] {
+	clone := set.empty()
+	clone.values = slices.Clone(set.values)
+	return clone
+}
+
+// DeepClone returns a copy of the set where every item is passed through the given copy function.
+// The copy function must not change the ordering of the items with respect to the set's comparator.
+func (set *Set[T]) DeepClone(copyValue func(value T) T) *Set[T] {
+	clone := set.Clone()
+	for index, value := range clone.values {
+		clone.values[index] = copyValue(value)
+	}
+	return clone
+}
+
+// Equal returns true if both sets hold the same items.
+// Items are compared with utils.DefaultEquality.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	return slices.EqualFunc(set.values, other.values, utils.DefaultEquality[T]())
+}
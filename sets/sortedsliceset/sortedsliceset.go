@@ -0,0 +1,327 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sortedsliceset implements a set backed by a sorted slice.
+//
+// Items are kept in a contiguous slice in ascending order and looked up with binary search.
+// Compared to a tree set there is no per-item allocation and iteration has good locality,
+// at the cost of O(n) insertion and removal. Best suited for small or read-mostly sets.
+// Sets created with NewWithNumberComparator or NewWithStringComparator search without calling the comparator.
+// In BenchmarkTreeSetVsSortedSliceSet of package treeset, all operations are faster than in a tree set up to
+// a hundred items, and only removal in random order breaks even at a thousand items.
+//
+// Structure is not thread safe.
+//
+// Reference: http://en.wikipedia.org/wiki/Set_%28abstract_data_type%29
+package sortedsliceset
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/sets"
+	"github.com/ugurcsen/gods-generic/utils"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Assert Set implementation
+var _ sets.Set[int] = (*Set[int])(nil)
+
+// Set holds elements in a sorted slice
+type Set[T comparable] struct {
+	values     []T
+	comparator utils.Comparator[T]
+	find       func(values []T, item T) (int, bool) // binary search, specialized for the number and string comparators
+	version    int                                  // incremented on every modification, used by iterators to detect concurrent modification
+}
+
+// NewWith instantiates a new empty set with the custom comparator.
+func NewWith[T comparable](comparator utils.Comparator[T], values ...T) *Set[T] {
+	return newWith(comparator, searchFunc(comparator), values)
+}
+
+// NewWithNumberComparator instantiates a new empty set with the IntComparator, i.e. keys are of type int.
+func NewWithNumberComparator(values ...int) *Set[int] {
+	return newWith(utils.NumberComparator[int], slices.BinarySearch[[]int], values)
+}
+
+// NewWithStringComparator instantiates a new empty set with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator(values ...string) *Set[string] {
+	return newWith(utils.StringComparator, slices.BinarySearch[[]string], values)
+}
+
+// newWith instantiates a new set with the comparator, searching it with find, and adds the passed values.
+func newWith[T comparable](comparator utils.Comparator[T], find func(values []T, item T) (int, bool), values []T) *Set[T] {
+	set := &Set[T]{comparator: comparator, find: find}
+	if len(values) > 0 {
+		set.Add(values...)
+	}
+	return set
+}
+
+// FromSorted instantiates a set with the custom comparator from values that are already
+// in ascending order without duplicates, in O(n).
+// The passed slice is copied.
+// Panics if the values are not strictly ascending.
+func FromSorted[T comparable](comparator utils.Comparator[T], values []T) *Set[T] {
+	for i := 1; i < len(values); i++ {
+		if comparator(values[i-1], values[i]) >= 0 {
+			panic("Invalid values, should be in ascending order without duplicates")
+		}
+	}
+	return &Set[T]{values: slices.Clone(values), comparator: comparator, find: searchFunc(comparator)}
+}
+
+// Add adds the items (one or more) to the set.
+func (set *Set[T]) Add(items ...T) {
+	for _, item := range items {
+		if index, found := set.search(item); !found {
+			set.version++
+			set.values = slices.Insert(set.values, index, item)
+		}
+	}
+}
+
+// Remove removes the items (one or more) from the set.
+func (set *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		if index, found := set.search(item); found {
+			set.removeAt(index)
+		}
+	}
+}
+
+// Contains checks weather items (one or more) are present in the set.
+// All items have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if _, found := set.search(item); !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) Empty() bool {
+	return len(set.values) == 0
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return len(set.values)
+}
+
+// Clear clears all values in the set.
+func (set *Set[T]) Clear() {
+	set.version++
+	set.values = nil
+}
+
+// Values returns all items in the set.
+func (set *Set[T]) Values() []T {
+	return slices.Clone(set.values)
+}
+
+// Min returns the smallest item of the set and true, or false if the set is empty.
+func (set *Set[T]) Min() (value T, found bool) {
+	if len(set.values) == 0 {
+		return value, false
+	}
+	return set.values[0], true
+}
+
+// Max returns the largest item of the set and true, or false if the set is empty.
+func (set *Set[T]) Max() (value T, found bool) {
+	if len(set.values) == 0 {
+		return value, false
+	}
+	return set.values[len(set.values)-1], true
+}
+
+// Floor returns the largest item that is smaller than or equal to the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Floor(item T) (value T, found bool) {
+	index, found := set.search(item)
+	if !found {
+		index--
+	}
+	return set.at(index)
+}
+
+// Ceiling returns the smallest item that is larger than or equal to the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Ceiling(item T) (value T, found bool) {
+	index, _ := set.search(item)
+	return set.at(index)
+}
+
+// Lower returns the largest item that is strictly smaller than the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Lower(item T) (value T, found bool) {
+	index, _ := set.search(item)
+	return set.at(index - 1)
+}
+
+// Higher returns the smallest item that is strictly larger than the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Higher(item T) (value T, found bool) {
+	index, found := set.search(item)
+	if found {
+		index++
+	}
+	return set.at(index)
+}
+
+// PollFirst removes the smallest item from the set and returns it and true, or false if the set is empty.
+func (set *Set[T]) PollFirst() (value T, found bool) {
+	if value, found = set.at(0); found {
+		set.removeAt(0)
+	}
+	return value, found
+}
+
+// PollLast removes the largest item from the set and returns it and true, or false if the set is empty.
+func (set *Set[T]) PollLast() (value T, found bool) {
+	last := len(set.values) - 1
+	if value, found = set.at(last); found {
+		set.removeAt(last)
+	}
+	return value, found
+}
+
+// String returns a string representation of container
+func (set *Set[T]) String() string {
+	str := "SortedSliceSet\n"
+	items := []string{}
+	for _, v := range set.values {
+		items = append(items, fmt.Sprintf("%v", v))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
+
+// Intersection returns the intersection between two sets.
+// The new set consists of all elements that are both in "set" and "another".
+// The two sets should have the same comparators, otherwise the result is empty set.
+// Both sets are merged in a single pass, in O(n+m).
+// Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
+func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
+	result := set.empty()
+	if !set.sameComparator(another) {
+		return result
+	}
+	set.merge(another, func(value T, inSet, inAnother bool) {
+		if inSet && inAnother {
+			result.values = append(result.values, value)
+		}
+	})
+	return result
+}
+
+// Union returns the union of two sets.
+// The new set consists of all elements that are in "set" or "another" (possibly both).
+// The two sets should have the same comparators, otherwise the result is empty set.
+// Both sets are merged in a single pass, in O(n+m).
+// Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
+func (set *Set[T]) Union(another *Set[T]) *Set[T] {
+	result := set.empty()
+	if !set.sameComparator(another) {
+		return result
+	}
+	result.values = make([]T, 0, max(len(set.values), len(another.values)))
+	set.merge(another, func(value T, inSet, inAnother bool) {
+		result.values = append(result.values, value)
+	})
+	return result
+}
+
+// Difference returns the difference between two sets.
+// The two sets should have the same comparators, otherwise the result is empty set.
+// The new set consists of all elements that are in "set" but not in "another".
+// Both sets are merged in a single pass, in O(n+m).
+// Ref: https://proofwiki.org/wiki/Definition:Set_Difference
+func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
+	result := set.empty()
+	if !set.sameComparator(another) {
+		return result
+	}
+	set.merge(another, func(value T, inSet, inAnother bool) {
+		if inSet && !inAnother {
+			result.values = append(result.values, value)
+		}
+	})
+	return result
+}
+
+// search returns the index of the item and true if the item is in the set,
+// otherwise the index at which the item would be inserted and false.
+func (set *Set[T]) search(item T) (int, bool) {
+	if len(set.values) == 0 {
+		return 0, false
+	}
+	return set.find(set.values, item)
+}
+
+// searchFunc returns a binary search over values sorted with the comparator.
+func searchFunc[T comparable](comparator utils.Comparator[T]) func(values []T, item T) (int, bool) {
+	return func(values []T, item T) (int, bool) {
+		return slices.BinarySearchFunc(values, item, comparator)
+	}
+}
+
+// empty returns an empty set with the comparator and search of the set.
+func (set *Set[T]) empty() *Set[T] {
+	return &Set[T]{comparator: set.comparator, find: set.find}
+}
+
+// at returns the item at the given index and true, or false if the index is out of bounds.
+func (set *Set[T]) at(index int) (value T, found bool) {
+	if index < 0 || index >= len(set.values) {
+		return value, false
+	}
+	return set.values[index], true
+}
+
+// removeAt removes the item at the given index, which must be within bounds.
+func (set *Set[T]) removeAt(index int) {
+	set.version++
+	var empty T
+	last := len(set.values) - 1
+	copy(set.values[index:], set.values[index+1:])
+	set.values[last] = empty // cleanup reference
+	set.values = set.values[:last]
+}
+
+// merge walks both sets in ascending order and calls f once for every item of either set,
+// telling in which of the sets the item is.
+func (set *Set[T]) merge(another *Set[T], f func(value T, inSet, inAnother bool)) {
+	i, j := 0, 0
+	for i < len(set.values) && j < len(another.values) {
+		switch compare := set.comparator(set.values[i], another.values[j]); {
+		case compare < 0:
+			f(set.values[i], true, false)
+			i++
+		case compare > 0:
+			f(another.values[j], false, true)
+			j++
+		default:
+			f(set.values[i], true, true)
+			i++
+			j++
+		}
+	}
+	for ; i < len(set.values); i++ {
+		f(set.values[i], true, false)
+	}
+	for ; j < len(another.values); j++ {
+		f(another.values[j], false, true)
+	}
+}
+
+// sameComparator returns true if both sets order their items with the same comparator.
+func (set *Set[T]) sameComparator(another *Set[T]) bool {
+	return reflect.ValueOf(set.comparator).Pointer() == reflect.ValueOf(another.comparator).Pointer()
+}
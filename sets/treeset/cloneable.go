@@ -0,0 +1,45 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package treeset
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Set[int], int] = (*Set[int])(nil)
+
+// Clone returns a copy of the set holding the same items.
+// The underlying tree is copied directly in O(n) without any rebalancing.
+func (set *Set[T]) Clone() *Set[T] {
+	return &Set[T]{tree: set.tree.Clone()}
+}
+
+// DeepClone returns a copy of the set where every item is passed through the given copy function.
+// The copy function must not change the ordering of the items with respect to the set's comparator.
+func (set *Set[T]) DeepClone(copyValue func(value T) T) *Set[T] {
+	clone := set.Clone()
+	for it := clone.tree.Iterator(); it.Next(); {
+		it.Node().Key = copyValue(it.Key())
+	}
+	return clone
+}
+
+// Equal returns true if both sets hold the same items.
+// Items are compared with utils.DefaultEquality.
+func (set *Set[T]) Equal(other *Set[T]) bool {
+	if set.Size() != other.Size() {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	it1, it2 := set.tree.Iterator(), other.tree.Iterator()
+	for it1.Next() && it2.Next() {
+		if !equal(it1.Key(), it2.Key()) {
+			return false
+		}
+	}
+	return true
+}
@@ -17,6 +17,7 @@ type Iterator[T comparable] struct {
 	index    int
 	iterator rbt.Iterator[T, struct{}]
 	tree     *rbt.Tree[T, struct{}]
+	removed  bool // current element was removed through the iterator
 }
 
 // Iterator holding the iterator's state
@@ -29,7 +30,10 @@ func (set *Set[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
-	if iterator.index < iterator.tree.Size() {
+	if iterator.removed {
+		// the element that followed the removed one has taken over its index
+		iterator.removed = false
+	} else if iterator.index < iterator.tree.Size() {
 		iterator.index++
 	}
 	return iterator.iterator.Next()
@@ -39,6 +43,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.removed = false
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -61,6 +66,7 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.removed = false
 	iterator.iterator.Begin()
 }
 
@@ -68,6 +74,7 @@ func (iterator *Iterator[T]) Begin() {
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.tree.Size()
+	iterator.removed = false
 	iterator.iterator.End()
 }
 
@@ -114,3 +121,16 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the set and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Value() is undefined until then.
+// Removing through the iterator is the only modification of the set that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Remove() bool {
+	if !iterator.iterator.Remove() {
+		return false
+	}
+	iterator.removed = true
+	return true
+}
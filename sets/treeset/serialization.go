@@ -7,6 +7,7 @@ package treeset
 import (
 	"encoding/json"
 	"github.com/ugurcsen/gods-generic/containers"
+	rbt "github.com/ugurcsen/gods-generic/trees/redblacktree"
 )
 
 // Assert Serialization implementation
@@ -19,16 +20,31 @@ func (set *Set[T]) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates the set from the input JSON representation.
+// Elements in ascending order, as output by ToJSON, are loaded in O(n) without rebalancing.
 func (set *Set[T]) FromJSON(data []byte) error {
 	elements := []T{}
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
 		set.Clear()
-		set.Add(elements...)
+		if set.ascending(elements) {
+			set.tree.Union(rbt.FromSorted(set.tree.Comparator, elements, make([]struct{}, len(elements))))
+		} else {
+			set.Add(elements...)
+		}
 	}
 	return err
 }
 
+// ascending returns true if the items are in ascending order without duplicates
+func (set *Set[T]) ascending(items []T) bool {
+	for i := 1; i < len(items); i++ {
+		if set.tree.Comparator(items[i-1], items[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // UnmarshalJSON @implements json.Unmarshaler
 func (set *Set[T]) UnmarshalJSON(bytes []byte) error {
 	return set.FromJSON(bytes)
@@ -99,6 +99,60 @@ func (set *Set[T]) Values() []T {
 	return set.tree.Keys()
 }
 
+// Floor returns the largest item that is smaller than or equal to the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Floor(item T) (value T, found bool) {
+	return key(set.tree.Floor(item))
+}
+
+// Ceiling returns the smallest item that is larger than or equal to the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Ceiling(item T) (value T, found bool) {
+	return key(set.tree.Ceiling(item))
+}
+
+// Lower returns the largest item that is strictly smaller than the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Lower(item T) (value T, found bool) {
+	return key(set.tree.Lower(item))
+}
+
+// Higher returns the smallest item that is strictly larger than the given item and true,
+// or false if there is no such item.
+func (set *Set[T]) Higher(item T) (value T, found bool) {
+	return key(set.tree.Higher(item))
+}
+
+// PollFirst removes the smallest item from the set and returns it and true, or false if the set is empty.
+func (set *Set[T]) PollFirst() (value T, found bool) {
+	node := set.tree.Left()
+	if value, found = key(node, node != nil); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// PollLast removes the largest item from the set and returns it and true, or false if the set is empty.
+func (set *Set[T]) PollLast() (value T, found bool) {
+	node := set.tree.Right()
+	if value, found = key(node, node != nil); found {
+		set.tree.Remove(value)
+	}
+	return value, found
+}
+
+// Descending returns a new set holding the same items ordered by the reverse of the set's comparator,
+// i.e. in descending order. The sets do not share their items, later changes to either are not reflected
+// in the other.
+func (set *Set[T]) Descending() *Set[T] {
+	descending := NewWith[T](utils.Reverse(set.tree.Comparator))
+	it := set.Iterator()
+	for it.End(); it.Prev(); {
+		descending.tree.Put(it.Value(), struct{}{})
+	}
+	return descending
+}
+
 // String returns a string representation of container
 func (set *Set[T]) String() string {
 	str := "TreeSet\n"
@@ -113,75 +167,82 @@ func (set *Set[T]) String() string {
 // Intersection returns the intersection between two sets.
 // The new set consists of all elements that are both in "set" and "another".
 // The two sets should have the same comparators, otherwise the result is empty set.
+// Copies both sets in O(n + m), see IntersectionWith to intersect in place.
 // Ref: https://en.wikipedia.org/wiki/Intersection_(set_theory)
 func (set *Set[T]) Intersection(another *Set[T]) *Set[T] {
-	result := NewWith[T](set.tree.Comparator)
-
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
-		return result
-	}
-
-	// Iterate over smaller set (optimization)
-	if set.Size() <= another.Size() {
-		for it := set.Iterator(); it.Next(); {
-			if another.Contains(it.Value()) {
-				result.Add(it.Value())
-			}
-		}
-	} else {
-		for it := another.Iterator(); it.Next(); {
-			if set.Contains(it.Value()) {
-				result.Add(it.Value())
-			}
-		}
+	if !set.sameComparator(another) {
+		return NewWith[T](set.tree.Comparator)
 	}
-
+	result := set.Clone()
+	result.IntersectionWith(another.Clone())
 	return result
 }
 
 // Union returns the union of two sets.
 // The new set consists of all elements that are in "set" or "another" (possibly both).
 // The two sets should have the same comparators, otherwise the result is empty set.
+// Copies both sets in O(n + m), see UnionWith to unite in place.
 // Ref: https://en.wikipedia.org/wiki/Union_(set_theory)
 func (set *Set[T]) Union(another *Set[T]) *Set[T] {
-	result := NewWith[T](set.tree.Comparator)
-
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
-		return result
-	}
-
-	for it := set.Iterator(); it.Next(); {
-		result.Add(it.Value())
-	}
-	for it := another.Iterator(); it.Next(); {
-		result.Add(it.Value())
+	if !set.sameComparator(another) {
+		return NewWith[T](set.tree.Comparator)
 	}
-
+	result := set.Clone()
+	result.UnionWith(another.Clone())
 	return result
 }
 
 // Difference returns the difference between two sets.
 // The two sets should have the same comparators, otherwise the result is empty set.
 // The new set consists of all elements that are in "set" but not in "another".
+// Copies both sets in O(n + m), see DifferenceWith to subtract in place.
 // Ref: https://proofwiki.org/wiki/Definition:Set_Difference
 func (set *Set[T]) Difference(another *Set[T]) *Set[T] {
-	result := NewWith[T](set.tree.Comparator)
-
-	setComparator := reflect.ValueOf(set.tree.Comparator)
-	anotherComparator := reflect.ValueOf(another.tree.Comparator)
-	if setComparator.Pointer() != anotherComparator.Pointer() {
-		return result
+	if !set.sameComparator(another) {
+		return NewWith[T](set.tree.Comparator)
 	}
+	result := set.Clone()
+	result.DifferenceWith(another.Clone())
+	return result
+}
 
-	for it := set.Iterator(); it.Next(); {
-		if !another.Contains(it.Value()) {
-			result.Add(it.Value())
-		}
-	}
+// IntersectionWith removes the elements that are not in "another" from the set and leaves "another" empty.
+// The two sets must have the same comparators.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger set.
+func (set *Set[T]) IntersectionWith(another *Set[T]) {
+	set.tree.Intersection(another.tree)
+}
 
-	return result
+// UnionWith moves the elements of "another" into the set and leaves "another" empty.
+// The two sets must have the same comparators.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger set.
+func (set *Set[T]) UnionWith(another *Set[T]) {
+	set.tree.Union(another.tree)
+}
+
+// DifferenceWith removes the elements that are in "another" from the set and leaves "another" empty.
+// The two sets must have the same comparators.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger set.
+func (set *Set[T]) DifferenceWith(another *Set[T]) {
+	set.tree.Difference(another.tree)
+}
+
+// Split moves the elements smaller than the given item to the left set and the other elements to the right set
+// in O(log n) and leaves the set empty. Both sets use the comparator of the set.
+func (set *Set[T]) Split(item T) (left *Set[T], right *Set[T]) {
+	leftTree, rightTree := set.tree.Split(item)
+	return &Set[T]{tree: leftTree}, &Set[T]{tree: rightTree}
+}
+
+// sameComparator returns true if both sets use the same comparator function.
+func (set *Set[T]) sameComparator(another *Set[T]) bool {
+	return reflect.ValueOf(set.tree.Comparator).Pointer() == reflect.ValueOf(another.tree.Comparator).Pointer()
+}
+
+// key returns the key of the node if found, otherwise the zero value.
+func key[T comparable](node *rbt.Node[T, struct{}], found bool) (value T, ok bool) {
+	if !found {
+		return value, false
+	}
+	return node.Key, true
 }
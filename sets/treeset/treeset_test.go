@@ -7,7 +7,12 @@ package treeset
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/sets"
+	"github.com/ugurcsen/gods-generic/sets/sortedsliceset"
 	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -470,6 +475,12 @@ func TestSetSerialization(t *testing.T) {
 	err = set.FromJSON(bytes)
 	assert()
 
+	err = set.FromJSON([]byte(`["c","a","b","a"]`)) // unsorted input is added one by one
+	assert()
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
 	bytes, err = json.Marshal([]interface{}{"a", "b", "c", set})
 	if err != nil {
 		t.Errorf("Got error %v", err)
@@ -588,6 +599,243 @@ func TestSetDifference(t *testing.T) {
 	}
 }
 
+func TestSetSplit(t *testing.T) {
+	set := NewWithNumberComparator(5, 1, 4, 2, 3)
+	left, right := set.Split(3)
+	if actualValue, expectedValue := fmt.Sprint(left.Values(), right.Values(), set.Size()), "[1 2] [3 4 5] 0"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	left.Add(0)
+	if actualValue, expectedValue := left.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetInPlaceOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation func(set, another *Set[int])
+		expected  string
+	}{
+		{"union", (*Set[int]).UnionWith, "[1 2 3 4 5]"},
+		{"intersection", (*Set[int]).IntersectionWith, "[2 3]"},
+		{"difference", (*Set[int]).DifferenceWith, "[1]"},
+	}
+	for _, test := range tests {
+		set, another := NewWithNumberComparator(1, 2, 3), NewWithNumberComparator(2, 3, 4, 5)
+		test.operation(set, another)
+		if actualValue, expectedValue := fmt.Sprint(set.Values()), test.expected; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+		}
+		if actualValue, expectedValue := another.Empty(), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+		}
+	}
+
+	// the operations returning a new set leave both sets unchanged
+	set, another := NewWithNumberComparator(1, 2, 3), NewWithNumberComparator(2, 3, 4, 5)
+	set.Union(another)
+	set.Intersection(another)
+	set.Difference(another)
+	if actualValue, expectedValue := fmt.Sprint(set.Values(), another.Values()), "[1 2 3] [2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetClone(t *testing.T) {
+	set := NewWithStringComparator("c", "a", "b")
+	clone := set.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", clone.Values()[0], clone.Values()[1], clone.Values()[2]), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Remove("a")
+	clone.Add("d")
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", set.Values()[0], set.Values()[1], set.Values()[2]), "abc"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := set.Contains("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	deepClone := set.DeepClone(strings.ToUpper)
+	if actualValue, expectedValue := fmt.Sprintf("%s%s%s", deepClone.Values()[0], deepClone.Values()[1], deepClone.Values()[2]), "ABC"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := deepClone.Contains("A", "B", "C"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestSetEqual(t *testing.T) {
+	set := NewWithStringComparator("c", "a", "b")
+	if actualValue := set.Equal(set.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(NewWithStringComparator("a", "b", "c")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Equal(NewWithStringComparator("c", "a")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestSetNewWithCombinedComparator(t *testing.T) {
+	set := NewWith(utils.ThenComparing(utils.CaseInsensitiveStringComparator, utils.Reverse(utils.StringComparator)), "b", "A", "a", "B")
+	if actualValue, expectedValue := fmt.Sprintf("%v", set.Values()), "[a A b B]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	natural := NewWith(utils.NaturalStringComparator, "file10", "file2", "file1")
+	if actualValue, expectedValue := fmt.Sprintf("%v", natural.Values()), "[file1 file2 file10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	set := NewWithNumberComparator(1, 2, 3)
+	it := set.Iterator()
+	it.Next()
+	set.Add(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	set.Remove(1) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestSetIteratorRemove(t *testing.T) {
+	set := NewWithNumberComparator(1, 2, 3, 4, 5, 6)
+	it := set.Iterator()
+	if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var visited []string
+	for it.Next() {
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+		if it.Value()%2 == 0 {
+			if actualValue, expectedValue := it.Remove(), true; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if actualValue, expectedValue := it.Remove(), false; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[0:1 1:2 1:3 2:4 2:5 3:6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 3 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Contains(2), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	visited = nil
+	it.End()
+	for it.Prev() {
+		visited = append(visited, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+		if it.Value() == 3 {
+			it.Remove()
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(visited), "[2:5 1:3 0:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	set.Add(3)
+	if actualValue, expectedValue := set.Contains(3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetNavigation(t *testing.T) {
+	set := NewWithNumberComparator()
+	for _, f := range []func(int) (int, bool){set.Floor, set.Ceiling, set.Lower, set.Higher} {
+		if actualValue, found := f(1); actualValue != 0 || found {
+			t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 0, false)
+		}
+	}
+	set.Add(7, 3, 0)
+
+	// item, expectedFloor, expectedCeiling, expectedLower, expectedHigher (-1 if not found)
+	tests := [][]int{
+		{-1, -1, 0, -1, 0},
+		{0, 0, 0, -1, 3},
+		{1, 0, 3, 0, 3},
+		{3, 3, 3, 0, 7},
+		{5, 3, 7, 3, 7},
+		{7, 7, 7, 3, -1},
+		{8, 7, -1, 7, -1},
+	}
+	for _, test := range tests {
+		for i, f := range []func(int) (int, bool){set.Floor, set.Ceiling, set.Lower, set.Higher} {
+			expectedValue, expectedFound := max(test[i+1], 0), test[i+1] >= 0
+			if actualValue, found := f(test[0]); actualValue != expectedValue || found != expectedFound {
+				t.Errorf("Got %v, %v, expected %v, %v for %v", actualValue, found, expectedValue, expectedFound, test)
+			}
+		}
+	}
+}
+
+func TestSetPollFirstAndLast(t *testing.T) {
+	set := NewWithNumberComparator()
+	if actualValue, found := set.PollFirst(); actualValue != 0 || found {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 0, false)
+	}
+	if actualValue, found := set.PollLast(); actualValue != 0 || found {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 0, false)
+	}
+	set.Add(2, 0, 3)
+	if actualValue, found := set.PollFirst(); actualValue != 0 || !found {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 0, true)
+	}
+	if actualValue, found := set.PollLast(); actualValue != 3 || !found {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 3, true)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetDescending(t *testing.T) {
+	set := NewWithNumberComparator(2, 1, 3)
+	descending := set.Descending()
+	if actualValue, expectedValue := fmt.Sprint(descending.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := descending.Higher(2); actualValue != 1 || !found {
+		t.Errorf("Got %v, %v, expected %v, %v", actualValue, found, 1, true)
+	}
+	descending.Add(0)
+	if actualValue, expectedValue := fmt.Sprint(descending.Values()), "[3 2 1 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(set.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSetConformance(t *testing.T) {
+	containertest.TestSet(t, func() sets.Set[int] { return NewWithNumberComparator() })
+}
+
 func benchmarkContains(b *testing.B, set *Set[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -740,3 +988,53 @@ func BenchmarkTreeSetRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, set, size)
 }
+
+// BenchmarkTreeSetVsSortedSliceSet compares the tree set with the sorted slice set on the same operations,
+// going through the sets.Set interface for both and visiting the items in random order.
+func BenchmarkTreeSetVsSortedSliceSet(b *testing.B) {
+	implementations := []struct {
+		name string
+		new  func() sets.Set[int]
+	}{
+		{"TreeSet", func() sets.Set[int] { return NewWithNumberComparator() }},
+		{"SortedSliceSet", func() sets.Set[int] { return sortedsliceset.NewWithNumberComparator() }},
+	}
+	for _, size := range []int{10, 100, 1000} {
+		order := rand.New(rand.NewSource(1)).Perm(size)
+		for _, implementation := range implementations {
+			b.Run(fmt.Sprintf("Contains/%s/%d", implementation.name, size), func(b *testing.B) {
+				m := implementation.new()
+				for _, n := range order {
+					m.Add(n)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					for _, n := range order {
+						m.Contains(n)
+					}
+				}
+			})
+			b.Run(fmt.Sprintf("Add/%s/%d", implementation.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					m := implementation.new()
+					for _, n := range order {
+						m.Add(n)
+					}
+				}
+			})
+			b.Run(fmt.Sprintf("Remove/%s/%d", implementation.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					m := implementation.new()
+					for _, n := range order {
+						m.Add(n)
+					}
+					b.StartTimer()
+					for _, n := range order {
+						m.Remove(n)
+					}
+				}
+			})
+		}
+	}
+}
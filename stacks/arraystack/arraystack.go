@@ -20,25 +20,30 @@ import (
 var _ stacks.Stack[int] = (*Stack[int])(nil)
 
 // Stack holds elements in an array-list
-type Stack[T comparable] struct {
-	list *arraylist.List[T]
+type Stack[T any] struct {
+	list    *arraylist.List[T]
+	version int // incremented on every modification, used by iterators to detect concurrent modification
 }
 
 // New instantiates a new empty stack
-func New[T comparable]() *Stack[T] {
+func New[T any]() *Stack[T] {
 	return &Stack[T]{list: arraylist.New[T]()}
 }
 
 // Push adds a value onto the top of the stack
 func (stack *Stack[T]) Push(value T) {
 	stack.list.Add(value)
+	stack.version++
 }
 
 // Pop removes top element on stack and returns it, or nil if stack is empty.
 // Second return parameter is true, unless the stack was empty and there was nothing to pop.
 func (stack *Stack[T]) Pop() (value T, ok bool) {
 	value, ok = stack.list.Get(stack.list.Size() - 1)
-	stack.list.Remove(stack.list.Size() - 1)
+	if ok {
+		stack.list.Remove(stack.list.Size() - 1)
+		stack.version++
+	}
 	return
 }
 
@@ -61,6 +66,7 @@ func (stack *Stack[T]) Size() int {
 // Clear removes all elements from the stack.
 func (stack *Stack[T]) Clear() {
 	stack.list.Clear()
+	stack.version++
 }
 
 // Values returns all elements in the stack (LIFO order).
@@ -7,6 +7,9 @@ package arraystack
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/stacks"
 	"github.com/ugurcsen/gods-generic/utils"
 	"strings"
 	"testing"
@@ -396,6 +399,95 @@ func TestStackString(t *testing.T) {
 	}
 }
 
+func TestStackClone(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	clone := stack.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(clone.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Pop()
+	clone.Push(4)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(stack.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := stack.DeepClone(func(value int) int { return value * 10 })
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Values())...), "302010"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackEqual(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other := New[int]()
+	other.Push(2)
+	other.Push(1)
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Clear()
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[int]().Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestStackNonComparable(t *testing.T) {
+	stack := New[[]int]()
+	stack.Push([]int{1})
+	stack.Push([]int{2, 3})
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := stack.Pop(); len(actualValue) != 2 || actualValue[0] != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "[2 3]")
+	}
+}
+
+func TestStackIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	it := stack.Iterator()
+	it.Next()
+	stack.Push(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	stack.Pop() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestStackConformance(t *testing.T) {
+	containertest.TestStack(t, func() stacks.Stack[int] { return New[int]() })
+}
+
 func benchmarkPush(b *testing.B, stack *Stack[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
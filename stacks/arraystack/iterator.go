@@ -10,14 +10,16 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
-type Iterator[T comparable] struct {
-	stack *Stack[T]
-	index int
+type Iterator[T any] struct {
+	stack   *Stack[T]
+	index   int
+	version int
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the stack is modified during iteration.
 func (stack *Stack[T]) Iterator() Iterator[T] {
-	return Iterator[T]{stack: stack, index: -1}
+	return Iterator[T]{stack: stack, index: -1, version: stack.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +27,10 @@ func (stack *Stack[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.stack.version
+	}
+	iterator.checkVersion()
 	if iterator.index < iterator.stack.Size() {
 		iterator.index++
 	}
@@ -35,6 +41,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -44,6 +51,7 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
 	value, _ := iterator.stack.list.Get(iterator.stack.list.Size() - iterator.index - 1) // in reverse (LIFO)
 	return value
 }
@@ -58,12 +66,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.version = iterator.stack.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.stack.Size()
+	iterator.version = iterator.stack.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -109,3 +119,10 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkVersion panics if the stack was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.stack.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package boundedstack implements a stack with a fixed capacity backed by a circular buffer.
+//
+// Pushing onto a full stack follows the overflow policy given at construction time:
+// the oldest (bottom) element is dropped, the pushed value is rejected, or it is rejected and TryPush reports an error.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Stack_%28abstract_data_type%29
+package boundedstack
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ugurcsen/gods-generic/stacks"
+	"strings"
+)
+
+// Assert Stack implementation
+var _ stacks.Stack[int] = (*Stack[int])(nil)
+
+// ErrFull is returned by TryPush when the stack is full and its overflow policy is Error.
+var ErrFull = errors.New("boundedstack: stack is full")
+
+// OverflowPolicy defines what happens when a value is pushed onto a full stack.
+type OverflowPolicy int
+
+const (
+	// DropOldest drops the oldest (bottom) element to make room for the pushed value.
+	DropOldest OverflowPolicy = iota
+	// Reject silently discards the pushed value.
+	Reject
+	// Error discards the pushed value like Reject, but TryPush returns ErrFull.
+	// Push never reports an error, so TryPush has to be used to detect the overflow.
+	Error
+)
+
+// Stack holds elements in a circular buffer, where the bottom element is at index start
+type Stack[T any] struct {
+	values  []T
+	start   int
+	size    int
+	policy  OverflowPolicy
+	version int // incremented on every modification, used by iterators to detect concurrent modification
+}
+
+// New instantiates a new empty stack that holds at most capacity elements and handles overflows with the policy.
+// The capacity cannot be changed.
+func New[T any](capacity int, policy OverflowPolicy) *Stack[T] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Stack[T]{values: make([]T, capacity), policy: policy}
+}
+
+// Push adds a value onto the top of the stack.
+// If the stack is full, the value is handled according to the overflow policy; with Reject and Error policies the
+// value is discarded without any effect on the stack.
+func (stack *Stack[T]) Push(value T) {
+	_ = stack.TryPush(value)
+}
+
+// TryPush adds a value onto the top of the stack.
+// If the stack is full, the value is handled according to the overflow policy;
+// with Error policy the value is discarded and ErrFull is returned.
+func (stack *Stack[T]) TryPush(value T) error {
+	if stack.Full() {
+		switch stack.policy {
+		case Reject:
+			return nil
+		case Error:
+			return ErrFull
+		}
+		// drop oldest, its slot becomes the new top
+		stack.values[stack.start] = value
+		stack.start = stack.index(1)
+		stack.version++
+		return nil
+	}
+	stack.values[stack.index(stack.size)] = value
+	stack.size++
+	stack.version++
+	return nil
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack[T]) Pop() (value T, ok bool) {
+	if stack.size == 0 {
+		return value, false
+	}
+	stack.size--
+	stack.version++
+	top := stack.index(stack.size)
+	value = stack.values[top]
+	var empty T
+	stack.values[top] = empty // cleanup reference
+	return value, true
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack[T]) Peek() (value T, ok bool) {
+	if stack.size == 0 {
+		return value, false
+	}
+	return stack.values[stack.index(stack.size-1)], true
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack[T]) Empty() bool {
+	return stack.size == 0
+}
+
+// Full returns true if the stack holds as many elements as its capacity.
+func (stack *Stack[T]) Full() bool {
+	return stack.size == len(stack.values)
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack[T]) Size() int {
+	return stack.size
+}
+
+// Capacity returns the maximum number of elements the stack can hold.
+func (stack *Stack[T]) Capacity() int {
+	return len(stack.values)
+}
+
+// Policy returns the overflow policy of the stack.
+func (stack *Stack[T]) Policy() OverflowPolicy {
+	return stack.policy
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack[T]) Clear() {
+	clear(stack.values)
+	stack.start = 0
+	stack.size = 0
+	stack.version++
+}
+
+// Values returns all elements in the stack (LIFO order).
+func (stack *Stack[T]) Values() []T {
+	values := make([]T, stack.size, stack.size)
+	for i := 0; i < stack.size; i++ {
+		values[i] = stack.values[stack.index(stack.size-i-1)] // in reverse (LIFO)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (stack *Stack[T]) String() string {
+	str := "BoundedStack\n"
+	values := []string{}
+	for i := 0; i < stack.size; i++ {
+		values = append(values, fmt.Sprintf("%v", stack.values[stack.index(i)]))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Returns the position in the buffer of the element that is offset elements above the bottom element
+func (stack *Stack[T]) index(offset int) int {
+	return (stack.start + offset) % len(stack.values)
+}
+
+// Check that the index is within bounds of the stack
+func (stack *Stack[T]) withinRange(index int) bool {
+	return index >= 0 && index < stack.size
+}
@@ -0,0 +1,706 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boundedstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/stacks"
+	"github.com/ugurcsen/gods-generic/utils"
+	"strings"
+	"testing"
+)
+
+func TestStackPush(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	if actualValue := stack.Values(); actualValue[0] != 3 || actualValue[1] != 2 || actualValue[2] != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "[3,2,1]")
+	}
+	if actualValue := stack.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := stack.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPeek(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	var empty int
+	if actualValue, ok := stack.Peek(); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPop(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Pop()
+	if actualValue, ok := stack.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	var empty int
+	if actualValue, ok := stack.Pop(); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := stack.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestStackIteratorOnEmpty(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	it := stack.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestStackIteratorNext(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, count-1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	stack.Clear()
+	it = stack.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestStackIteratorPrev(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	for it.Next() {
+	}
+	count := 0
+	for it.Prev() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, 3-count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackIteratorBegin(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	it := stack.Iterator()
+	it.Begin()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
+	}
+}
+
+func TestStackIteratorEnd(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	it := stack.Iterator()
+
+	if index := it.Index(); index != -1 {
+		t.Errorf("Got %v expected %v", index, -1)
+	}
+
+	it.End()
+	if index := it.Index(); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	it.End()
+	if index := it.Index(); index != stack.Size() {
+		t.Errorf("Got %v expected %v", index, stack.Size())
+	}
+
+	it.Prev()
+	if index, value := it.Index(), it.Value(); index != stack.Size()-1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, stack.Size()-1, "a")
+	}
+}
+
+func TestStackIteratorFirst(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	it := stack.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
+	}
+}
+
+func TestStackIteratorLast(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	it := stack.Iterator()
+	if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "a")
+	}
+}
+
+func TestStackIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// NextTo (empty)
+	{
+		stack := New[string](10, DropOldest)
+		it := stack.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		stack := New[string](10, DropOldest)
+		stack.Push("xx")
+		stack.Push("yy")
+		it := stack.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// NextTo (found)
+	{
+		stack := New[string](10, DropOldest)
+		stack.Push("aa")
+		stack.Push("bb")
+		stack.Push("cc")
+		it := stack.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 2 || value != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "aa")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestStackIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// PrevTo (empty)
+	{
+		stack := New[string](10, DropOldest)
+		it := stack.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		stack := New[string](10, DropOldest)
+		stack.Push("xx")
+		stack.Push("yy")
+		it := stack.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		stack := New[string](10, DropOldest)
+		stack.Push("aa")
+		stack.Push("bb")
+		stack.Push("cc")
+		it := stack.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 0 || value != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "cc")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+func TestStackSerialization(t *testing.T) {
+	stack := New[string](10, DropOldest)
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(stack.Values())...), "cba"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := stack.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := stack.ToJSON()
+	assert()
+
+	err = stack.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", stack})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	stack2 := New[int](10, DropOldest)
+	err = json.Unmarshal([]byte(`[1,2,3]`), &stack2)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestStackString(t *testing.T) {
+	c := New[int](10, DropOldest)
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "BoundedStack") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestStackClone(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	clone := stack.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(clone.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Pop()
+	clone.Push(4)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(stack.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := stack.DeepClone(func(value int) int { return value * 10 })
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Values())...), "302010"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackEqual(t *testing.T) {
+	stack := New[int](10, DropOldest)
+	stack.Push(1)
+	stack.Push(2)
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other := New[int](10, DropOldest)
+	other.Push(2)
+	other.Push(1)
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Clear()
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := New[int](10, DropOldest).Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestStackNonComparable(t *testing.T) {
+	stack := New[[]int](10, DropOldest)
+	stack.Push([]int{1})
+	stack.Push([]int{2, 3})
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := stack.Pop(); len(actualValue) != 2 || actualValue[0] != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "[2 3]")
+	}
+}
+
+func TestStackDropOldest(t *testing.T) {
+	stack := New[int](3, DropOldest)
+	for i := 1; i <= 5; i++ {
+		if err := stack.TryPush(i); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[5 4 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := stack.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	stack.Pop()
+	stack.Push(6)
+	stack.Push(7)
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[7 6 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it := stack.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Value(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	bytes, err := stack.ToJSON()
+	if actualValue, expectedValue := string(bytes), "[4,6,7]"; actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := stack.FromJSON([]byte(`[1,2,3,4,5]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[5 4 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone := stack.Clone()
+	clone.Push(6)
+	if actualValue, expectedValue := fmt.Sprintf("%v", clone.Values()), "[6 5 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Capacity(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackReject(t *testing.T) {
+	stack := New[int](2, Reject)
+	for i := 1; i <= 3; i++ {
+		if err := stack.TryPush(i); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+	stack.Push(4)
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := stack.FromJSON([]byte(`[5,6,7]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[6 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackError(t *testing.T) {
+	stack := New[int](2, Error)
+	stack.Push(1)
+	if err := stack.TryPush(2); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := stack.TryPush(3); err != ErrFull {
+		t.Errorf("Got %v expected %v", err, ErrFull)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := stack.FromJSON([]byte(`[4,5,6]`)); err != ErrFull {
+		t.Errorf("Got %v expected %v", err, ErrFull)
+	}
+	stack.Push(7) // discarded
+	if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), "[5 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackPushFull(t *testing.T) {
+	tests := [][]interface{}{
+		{DropOldest, "[4 3 2]"},
+		{Reject, "[3 2 1]"},
+		{Error, "[3 2 1]"},
+	}
+	for _, test := range tests {
+		stack := New[int](3, test[0].(OverflowPolicy))
+		stack.Push(1)
+		stack.Push(2)
+		stack.Push(3)
+		stack.Push(4)
+		if actualValue, expectedValue := fmt.Sprintf("%v", stack.Values()), test[1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := stack.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestStackInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got %v expected %v", r, "panic")
+		}
+	}()
+	New[int](0, DropOldest)
+}
+
+func TestStackIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	stack := New[int](5, DropOldest)
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	it := stack.Iterator()
+	it.Next()
+	stack.Push(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	stack.Pop() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestStackConformance(t *testing.T) {
+	containertest.TestStack(t, func() stacks.Stack[int] { return New[int](10, Error) })
+}
+
+func benchmarkPush(b *testing.B, stack *Stack[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			stack.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, stack *Stack[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			stack.Pop()
+		}
+	}
+}
+
+func BenchmarkBoundedStackPop100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkBoundedStackPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkBoundedStackPop10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkBoundedStackPop100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkBoundedStackPush100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	stack := New[int](size, DropOldest)
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkBoundedStackPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkBoundedStackPush10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkBoundedStackPush100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	stack := New[int](size, DropOldest)
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
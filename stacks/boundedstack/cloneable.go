@@ -0,0 +1,42 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boundedstack
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Stack[int], int] = (*Stack[int])(nil)
+
+// Clone returns a copy of the stack holding the same elements in the same order, with the same capacity and policy.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	return stack.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the stack where every element is passed through the given copy function.
+func (stack *Stack[T]) DeepClone(copyValue func(value T) T) *Stack[T] {
+	newStack := &Stack[T]{values: make([]T, len(stack.values)), size: stack.size, policy: stack.policy}
+	for i := 0; i < stack.size; i++ {
+		newStack.values[i] = copyValue(stack.values[stack.index(i)])
+	}
+	return newStack
+}
+
+// Equal returns true if both stacks hold equal elements in the same order.
+// Capacity and policy are not compared. Elements are compared with utils.DefaultEquality.
+func (stack *Stack[T]) Equal(other *Stack[T]) bool {
+	if stack.size != other.size {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for i := 0; i < stack.size; i++ {
+		if !equal(stack.values[stack.index(i)], other.values[other.index(i)]) {
+			return false
+		}
+	}
+	return true
+}
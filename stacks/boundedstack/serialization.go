@@ -0,0 +1,49 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package boundedstack
+
+import (
+	"encoding/json"
+	"github.com/ugurcsen/gods-generic/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Stack[int])(nil)
+var _ containers.JSONDeserializer = (*Stack[int])(nil)
+
+// ToJSON outputs the JSON representation of the stack, from the bottom to the top element.
+func (stack *Stack[T]) ToJSON() ([]byte, error) {
+	values := make([]T, stack.size, stack.size)
+	for i := 0; i < stack.size; i++ {
+		values[i] = stack.values[stack.index(i)]
+	}
+	return json.Marshal(values)
+}
+
+// FromJSON populates the stack from the input JSON representation, pushing the elements from the bottom to the top.
+// Elements beyond the capacity are handled according to the overflow policy, ErrFull is returned with Error policy.
+func (stack *Stack[T]) FromJSON(data []byte) error {
+	var values []T
+	err := json.Unmarshal(data, &values)
+	if err == nil {
+		stack.Clear()
+		for _, value := range values {
+			if err := stack.TryPush(value); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (stack *Stack[T]) UnmarshalJSON(bytes []byte) error {
+	return stack.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (stack *Stack[T]) MarshalJSON() ([]byte, error) {
+	return stack.ToJSON()
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linkedliststack
+
+import "github.com/ugurcsen/gods-generic/containers"
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Stack[int], int] = (*Stack[int])(nil)
+
+// Clone returns a copy of the stack holding the same elements in the same order.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{list: stack.list.Clone()}
+}
+
+// DeepClone returns a copy of the stack where every element is passed through the given copy function.
+func (stack *Stack[T]) DeepClone(copyValue func(value T) T) *Stack[T] {
+	return &Stack[T]{list: stack.list.DeepClone(copyValue)}
+}
+
+// Equal returns true if both stacks hold equal elements in the same order.
+func (stack *Stack[T]) Equal(other *Stack[T]) bool {
+	return stack.list.Equal(other.list)
+}
@@ -10,14 +10,16 @@ import "github.com/ugurcsen/gods-generic/containers"
 var _ containers.IteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
-type Iterator[T comparable] struct {
-	stack *Stack[T]
-	index int
+type Iterator[T any] struct {
+	stack   *Stack[T]
+	index   int
+	version int
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the stack is modified during iteration.
 func (stack *Stack[T]) Iterator() Iterator[T] {
-	return Iterator[T]{stack: stack, index: -1}
+	return Iterator[T]{stack: stack, index: -1, version: stack.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -25,6 +27,10 @@ func (stack *Stack[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.stack.version
+	}
+	iterator.checkVersion()
 	if iterator.index < iterator.stack.Size() {
 		iterator.index++
 	}
@@ -34,6 +40,7 @@ func (iterator *Iterator[T]) Next() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
+	iterator.checkVersion()
 	value, _ := iterator.stack.list.Get(iterator.index) // in reverse (LIFO)
 	return value
 }
@@ -48,6 +55,7 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.version = iterator.stack.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -71,3 +79,10 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 	}
 	return false
 }
+
+// checkVersion panics if the stack was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.stack.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -19,6 +19,7 @@ func (stack *Stack[T]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the stack from the input JSON representation.
 func (stack *Stack[T]) FromJSON(data []byte) error {
+	stack.version++
 	return stack.list.FromJSON(data)
 }
 
@@ -0,0 +1,45 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxstack
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Stack[int], int] = (*Stack[int])(nil)
+
+// Clone returns a copy of the stack holding the same elements in the same order.
+func (stack *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{list: stack.list.Clone(), Comparator: stack.Comparator}
+}
+
+// DeepClone returns a copy of the stack where every element is passed through the given copy function.
+// The minimum and maximum are tracked again for the copied elements.
+func (stack *Stack[T]) DeepClone(copyValue func(value T) T) *Stack[T] {
+	newStack := NewWith(stack.Comparator)
+	stack.list.Each(func(_ int, entry entry[T]) {
+		newStack.Push(copyValue(entry.value))
+	})
+	return newStack
+}
+
+// Equal returns true if both stacks hold equal elements in the same order.
+// Elements are compared with utils.DefaultEquality.
+func (stack *Stack[T]) Equal(other *Stack[T]) bool {
+	if stack.Size() != other.Size() {
+		return false
+	}
+	equal := utils.DefaultEquality[T]()
+	for index := 0; index < stack.Size(); index++ {
+		entry1, _ := stack.list.Get(index)
+		entry2, _ := other.list.Get(index)
+		if !equal(entry1.value, entry2.value) {
+			return false
+		}
+	}
+	return true
+}
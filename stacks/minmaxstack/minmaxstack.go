@@ -0,0 +1,148 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package minmaxstack implements a stack that tracks its minimum and maximum element.
+//
+// Every element is stored together with the minimum and maximum of the elements below it (inclusive),
+// so that Min and Max run in constant time regardless of pushes and pops.
+// Comparator defines the ordering of the elements.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Stack_%28abstract_data_type%29
+package minmaxstack
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/lists/arraylist"
+	"github.com/ugurcsen/gods-generic/stacks"
+	"github.com/ugurcsen/gods-generic/utils"
+	"strings"
+)
+
+// Assert Stack implementation
+var _ stacks.Stack[int] = (*Stack[int])(nil)
+
+// Stack holds elements in an array-list, each along with the minimum and maximum up to it
+type Stack[T any] struct {
+	list       *arraylist.List[entry[T]]
+	Comparator utils.Comparator[T]
+	version    int // incremented on every modification, used by iterators to detect concurrent modification
+}
+
+type entry[T any] struct {
+	value T
+	min   T
+	max   T
+}
+
+// NewWith instantiates a new empty stack with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Stack[T] {
+	return &Stack[T]{list: arraylist.New[entry[T]](), Comparator: comparator}
+}
+
+// NewWithNumberComparator instantiates a new empty stack with the NumberComparator, i.e. elements are numbers.
+func NewWithNumberComparator[T utils.ComparableNumber]() *Stack[T] {
+	return NewWith(utils.NumberComparator[T])
+}
+
+// NewWithStringComparator instantiates a new empty stack with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Stack[string] {
+	return NewWith(utils.StringComparator)
+}
+
+// Push adds a value onto the top of the stack
+func (stack *Stack[T]) Push(value T) {
+	newEntry := entry[T]{value: value, min: value, max: value}
+	if top, ok := stack.top(); ok {
+		if stack.Comparator(top.min, value) <= 0 {
+			newEntry.min = top.min
+		}
+		if stack.Comparator(top.max, value) >= 0 {
+			newEntry.max = top.max
+		}
+	}
+	stack.list.Add(newEntry)
+	stack.version++
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack[T]) Pop() (value T, ok bool) {
+	top, ok := stack.top()
+	if ok {
+		stack.list.Remove(stack.list.Size() - 1)
+		stack.version++
+	}
+	return top.value, ok
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack[T]) Peek() (value T, ok bool) {
+	top, ok := stack.top()
+	return top.value, ok
+}
+
+// Min returns the smallest element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty.
+func (stack *Stack[T]) Min() (value T, ok bool) {
+	top, ok := stack.top()
+	return top.min, ok
+}
+
+// Max returns the greatest element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty.
+func (stack *Stack[T]) Max() (value T, ok bool) {
+	top, ok := stack.top()
+	return top.max, ok
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack[T]) Empty() bool {
+	return stack.list.Empty()
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack[T]) Size() int {
+	return stack.list.Size()
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack[T]) Clear() {
+	stack.list.Clear()
+	stack.version++
+}
+
+// Values returns all elements in the stack (LIFO order).
+func (stack *Stack[T]) Values() []T {
+	size := stack.list.Size()
+	values := make([]T, size, size)
+	for i := 1; i <= size; i++ {
+		entry, _ := stack.list.Get(i - 1)
+		values[size-i] = entry.value // in reverse (LIFO)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (stack *Stack[T]) String() string {
+	str := "MinMaxStack\n"
+	values := []string{}
+	stack.list.Each(func(_ int, entry entry[T]) {
+		values = append(values, fmt.Sprintf("%v", entry.value))
+	})
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Returns the entry on top of the stack
+func (stack *Stack[T]) top() (entry[T], bool) {
+	return stack.list.Get(stack.list.Size() - 1)
+}
+
+// Check that the index is within bounds of the list
+func (stack *Stack[T]) withinRange(index int) bool {
+	return index >= 0 && index < stack.list.Size()
+}
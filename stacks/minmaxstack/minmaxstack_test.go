@@ -0,0 +1,669 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/stacks"
+	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStackPush(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	if actualValue := stack.Values(); actualValue[0] != 3 || actualValue[1] != 2 || actualValue[2] != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "[3,2,1]")
+	}
+	if actualValue := stack.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := stack.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPeek(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	var empty int
+	if actualValue, ok := stack.Peek(); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPop(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Pop()
+	if actualValue, ok := stack.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	var empty int
+	if actualValue, ok := stack.Pop(); actualValue != empty || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := stack.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestStackIteratorOnEmpty(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	it := stack.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestStackIteratorNext(t *testing.T) {
+	stack := NewWithStringComparator()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, count-1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	stack.Clear()
+	it = stack.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestStackIteratorPrev(t *testing.T) {
+	stack := NewWithStringComparator()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	for it.Next() {
+	}
+	count := 0
+	for it.Prev() {
+		count++
+		index := it.Index()
+		value := it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := index, 3-count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackIteratorBegin(t *testing.T) {
+	stack := NewWithStringComparator()
+	it := stack.Iterator()
+	it.Begin()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
+	}
+}
+
+func TestStackIteratorEnd(t *testing.T) {
+	stack := NewWithStringComparator()
+	it := stack.Iterator()
+
+	if index := it.Index(); index != -1 {
+		t.Errorf("Got %v expected %v", index, -1)
+	}
+
+	it.End()
+	if index := it.Index(); index != 0 {
+		t.Errorf("Got %v expected %v", index, 0)
+	}
+
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	it.End()
+	if index := it.Index(); index != stack.Size() {
+		t.Errorf("Got %v expected %v", index, stack.Size())
+	}
+
+	it.Prev()
+	if index, value := it.Index(), it.Value(); index != stack.Size()-1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, stack.Size()-1, "a")
+	}
+}
+
+func TestStackIteratorFirst(t *testing.T) {
+	stack := NewWithStringComparator()
+	it := stack.Iterator()
+	if actualValue, expectedValue := it.First(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 0 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "c")
+	}
+}
+
+func TestStackIteratorLast(t *testing.T) {
+	stack := NewWithStringComparator()
+	it := stack.Iterator()
+	if actualValue, expectedValue := it.Last(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if index, value := it.Index(), it.Value(); index != 2 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "a")
+	}
+}
+
+func TestStackIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// NextTo (empty)
+	{
+		stack := NewWithStringComparator()
+		it := stack.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		stack := NewWithStringComparator()
+		stack.Push("xx")
+		stack.Push("yy")
+		it := stack.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// NextTo (found)
+	{
+		stack := NewWithStringComparator()
+		stack.Push("aa")
+		stack.Push("bb")
+		stack.Push("cc")
+		it := stack.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 2 || value != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "aa")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestStackIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// PrevTo (empty)
+	{
+		stack := NewWithStringComparator()
+		it := stack.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		stack := NewWithStringComparator()
+		stack.Push("xx")
+		stack.Push("yy")
+		it := stack.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		stack := NewWithStringComparator()
+		stack.Push("aa")
+		stack.Push("bb")
+		stack.Push("cc")
+		it := stack.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty stack")
+		}
+		if index, value := it.Index(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Index(), it.Value(); index != 0 || value != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "cc")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+func TestStackSerialization(t *testing.T) {
+	stack := NewWithStringComparator()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := fmt.Sprintf("%s%s%s", utils.GenericToInterfaceSlice(stack.Values())...), "cba"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := stack.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := stack.ToJSON()
+	assert()
+
+	err = stack.FromJSON(bytes)
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", stack})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	stack2 := NewWithNumberComparator[int]()
+	err = json.Unmarshal([]byte(`[1,2,3]`), &stack2)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestStackString(t *testing.T) {
+	c := NewWithNumberComparator[int]()
+	c.Push(1)
+	if !strings.HasPrefix(c.String(), "MinMaxStack") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestStackClone(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	clone := stack.Clone()
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(clone.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone.Pop()
+	clone.Push(4)
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(stack.Values())...), "321"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := stack.DeepClone(func(value int) int { return value * 10 })
+	if actualValue, expectedValue := fmt.Sprintf("%d%d%d", utils.GenericToInterfaceSlice(deepClone.Values())...), "302010"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackEqual(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	stack.Push(1)
+	stack.Push(2)
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other := NewWithNumberComparator[int]()
+	other.Push(2)
+	other.Push(1)
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Clear()
+	if actualValue := stack.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := NewWithNumberComparator[int]().Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestStackNonComparable(t *testing.T) {
+	stack := NewWith(func(a, b []int) int { return len(a) - len(b) })
+	stack.Push([]int{1})
+	stack.Push([]int{2, 3})
+	if actualValue := stack.Equal(stack.Clone()); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := stack.Max(); len(actualValue) != 2 || actualValue[0] != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "[2 3]")
+	}
+	if actualValue, ok := stack.Pop(); len(actualValue) != 2 || actualValue[0] != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "[2 3]")
+	}
+	if actualValue, ok := stack.Max(); len(actualValue) != 1 || actualValue[0] != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "[1]")
+	}
+}
+
+func TestStackMinMax(t *testing.T) {
+	stack := NewWithNumberComparator[int]()
+	if actualValue, ok := stack.Min(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, ok := stack.Max(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	tests := [][]interface{}{
+		{5, 5, 5},
+		{3, 3, 5},
+		{7, 3, 7},
+		{3, 3, 7},
+		{1, 1, 7},
+		{9, 1, 9},
+	}
+	for _, test := range tests {
+		stack.Push(test[0].(int))
+		if actualValue, ok := stack.Min(); actualValue != test[1].(int) || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if actualValue, ok := stack.Max(); actualValue != test[2].(int) || !ok {
+			t.Errorf("Got %v expected %v", actualValue, test[2])
+		}
+	}
+	for index := len(tests) - 1; index > 0; index-- {
+		if actualValue, ok := stack.Pop(); actualValue != tests[index][0].(int) || !ok {
+			t.Errorf("Got %v expected %v", actualValue, tests[index][0])
+		}
+		if actualValue, _ := stack.Min(); actualValue != tests[index-1][1].(int) {
+			t.Errorf("Got %v expected %v", actualValue, tests[index-1][1])
+		}
+		if actualValue, _ := stack.Max(); actualValue != tests[index-1][2].(int) {
+			t.Errorf("Got %v expected %v", actualValue, tests[index-1][2])
+		}
+	}
+	stack.Clear()
+	if _, ok := stack.Min(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestStackMinMaxRandom(t *testing.T) {
+	stack := NewWith(utils.Reverse(utils.NumberComparator[int]))
+	var values []int
+	for i := 0; i < 1000; i++ {
+		if rand.Intn(3) == 0 && len(values) > 0 {
+			stack.Pop()
+			values = values[:len(values)-1]
+		} else {
+			value := rand.Intn(100)
+			stack.Push(value)
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		// the comparator is reversed, so Min is the greatest number
+		if actualValue, _ := stack.Min(); actualValue != slices.Max(values) {
+			t.Errorf("Got %v expected %v", actualValue, slices.Max(values))
+		}
+		if actualValue, _ := stack.Max(); actualValue != slices.Min(values) {
+			t.Errorf("Got %v expected %v", actualValue, slices.Min(values))
+		}
+	}
+	clone := stack.DeepClone(func(value int) int { return -value })
+	if actualValue, _ := clone.Max(); len(values) > 0 && actualValue != -slices.Max(values) {
+		t.Errorf("Got %v expected %v", actualValue, -slices.Max(values))
+	}
+}
+
+func TestStackIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	stack := NewWithNumberComparator[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	it := stack.Iterator()
+	it.Next()
+	stack.Push(4)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	stack.Pop() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	stack.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestStackConformance(t *testing.T) {
+	containertest.TestStack(t, func() stacks.Stack[int] { return NewWithNumberComparator[int]() })
+}
+
+func benchmarkPush(b *testing.B, stack *Stack[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			stack.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, stack *Stack[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			stack.Pop()
+		}
+	}
+}
+
+func BenchmarkMinMaxStackPop100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPop10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPop100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPush100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	stack := NewWithNumberComparator[int]()
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPush10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
+
+func BenchmarkMinMaxStackPush100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	stack := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		stack.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPush(b, stack, size)
+}
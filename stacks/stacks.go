@@ -12,7 +12,7 @@ package stacks
 import "github.com/ugurcsen/gods-generic/containers"
 
 // Stack interface that all stacks implement
-type Stack[T comparable] interface {
+type Stack[T any] interface {
 	Push(value T)
 	Pop() (value T, ok bool)
 	Peek() (value T, ok bool)
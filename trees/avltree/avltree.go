@@ -12,6 +12,7 @@ package avltree
 import (
 	"fmt"
 
+	"github.com/ugurcsen/gods-generic/internal/arena"
 	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/utils"
 )
@@ -21,9 +22,11 @@ var _ trees.Tree[int] = new(Tree[int, int])
 
 // Tree holds elements of the AVL tree.
 type Tree[K comparable, T any] struct {
-	Root       *Node[K, T]         // Root node
-	Comparator utils.Comparator[K] // Key comparator
-	size       int                 // Total number of keys in the tree
+	Root       *Node[K, T]              // Root node
+	Comparator utils.Comparator[K]      // Key comparator
+	size       int                      // Total number of keys in the tree
+	version    int                      // Incremented on every modification, used by iterators to detect concurrent modification
+	nodes      *arena.Arena[Node[K, T]] // Allocates the nodes if set, otherwise they are allocated one by one
 }
 
 // Node is a single element within the tree
@@ -33,6 +36,7 @@ type Node[K comparable, T any] struct {
 	Parent   *Node[K, T]    // Parent node
 	Children [2]*Node[K, T] // Children nodes
 	b        int8
+	size     int // Number of nodes in the subtree rooted at the node
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
@@ -40,6 +44,14 @@ func NewWith[K comparable, T any](comparator utils.Comparator[K]) *Tree[K, T] {
 	return &Tree[K, T]{Comparator: comparator}
 }
 
+// NewWithArena instantiates an AVL tree with the custom comparator, whose nodes are allocated chunkSize at a time
+// and reused after removal. This reduces the number of heap objects, and thus the garbage collection work, of large
+// trees. A chunk is only reclaimed once all of its nodes are removed, and nodes obtained through GetNode, Floor,
+// Ceiling, etc. must not be used after their removal. A chunk size of one only reuses removed nodes.
+func NewWithArena[K comparable, T any](comparator utils.Comparator[K], chunkSize int) *Tree[K, T] {
+	return &Tree[K, T]{Comparator: comparator, nodes: arena.New[Node[K, T]](chunkSize)}
+}
+
 // NewWithNumberComparator instantiates an AVL tree with the IntComparator, i.e. keys are of type int.
 func NewWithNumberComparator[T any]() *Tree[int, T] {
 	return &Tree[int, T]{Comparator: utils.NumberComparator[int]}
@@ -94,7 +106,7 @@ func (t *Tree[K, T]) Remove(key K) {
 
 // Empty returns true if tree does not contain any nodes.
 func (t *Tree[K, T]) Empty() bool {
-	return t.size == 0
+	return t.Root == nil
 }
 
 // Size returns the number of elements stored in the tree.
@@ -103,24 +115,21 @@ func (t *Tree[K, T]) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
 func (n *Node[K, T]) Size() int {
 	if n == nil {
 		return 0
 	}
-	size := 1
-	if n.Children[0] != nil {
-		size += n.Children[0].Size()
-	}
-	if n.Children[1] != nil {
-		size += n.Children[1].Size()
-	}
-	return size
+	return n.size
+}
+
+// Recomputes the size of the node from the sizes of its children
+func (n *Node[K, T]) resize() {
+	n.size = 1 + n.Children[0].Size() + n.Children[1].Size()
 }
 
 // Keys returns all keys in-order
 func (t *Tree[K, T]) Keys() []K {
-	keys := make([]K, t.size)
+	keys := make([]K, t.Size())
 	it := t.Iterator()
 	for i := 0; it.Next(); i++ {
 		keys[i] = it.Key()
@@ -130,7 +139,7 @@ func (t *Tree[K, T]) Keys() []K {
 
 // Values returns all values in-order based on the key.
 func (t *Tree[K, T]) Values() []T {
-	values := make([]T, t.size)
+	values := make([]T, t.Size())
 	it := t.Iterator()
 	for i := 0; it.Next(); i++ {
 		values[i] = it.Value()
@@ -212,6 +221,10 @@ func (t *Tree[K, T]) Ceiling(key K) (floor *Node[K, T], found bool) {
 func (t *Tree[K, T]) Clear() {
 	t.Root = nil
 	t.size = 0
+	t.version++
+	if t.nodes != nil {
+		t.nodes.Reset()
+	}
 }
 
 // String returns a string representation of container
@@ -231,7 +244,8 @@ func (t *Tree[K, T]) put(key K, value T, p *Node[K, T], qp **Node[K, T]) bool {
 	q := *qp
 	if q == nil {
 		t.size++
-		*qp = &Node[K, T]{Key: key, Value: value, Parent: p}
+		t.version++
+		*qp = t.newNode(key, value, p)
 		return true
 	}
 
@@ -250,6 +264,7 @@ func (t *Tree[K, T]) put(key K, value T, p *Node[K, T], qp **Node[K, T]) bool {
 	a := (c + 1) / 2
 	var fix bool
 	fix = t.put(key, value, q, &q.Children[a])
+	q.resize()
 	if fix {
 		return putFix(int8(c), qp)
 	}
@@ -265,14 +280,18 @@ func (t *Tree[K, T]) remove(key K, qp **Node[K, T]) bool {
 	c := t.Comparator(key, q.Key)
 	if c == 0 {
 		t.size--
+		t.version++
+
 		if q.Children[1] == nil {
 			if q.Children[0] != nil {
 				q.Children[0].Parent = q.Parent
 			}
 			*qp = q.Children[0]
+			t.freeNode(q)
 			return true
 		}
-		fix := removeMin(&q.Children[1], &q.Key, &q.Value)
+		fix := t.removeMin(&q.Children[1], &q.Key, &q.Value)
+		q.resize()
 		if fix {
 			return removeFix(-1, qp)
 		}
@@ -286,13 +305,14 @@ func (t *Tree[K, T]) remove(key K, qp **Node[K, T]) bool {
 	}
 	a := (c + 1) / 2
 	fix := t.remove(key, &q.Children[a])
+	q.resize()
 	if fix {
 		return removeFix(int8(-c), qp)
 	}
 	return false
 }
 
-func removeMin[K comparable, T any](qp **Node[K, T], minKey *K, minVal *T) bool {
+func (t *Tree[K, T]) removeMin(qp **Node[K, T], minKey *K, minVal *T) bool {
 	q := *qp
 	if q.Children[0] == nil {
 		*minKey = q.Key
@@ -301,15 +321,34 @@ func removeMin[K comparable, T any](qp **Node[K, T], minKey *K, minVal *T) bool
 			q.Children[1].Parent = q.Parent
 		}
 		*qp = q.Children[1]
+		t.freeNode(q)
 		return true
 	}
-	fix := removeMin(&q.Children[0], minKey, minVal)
+	fix := t.removeMin(&q.Children[0], minKey, minVal)
+	q.resize()
 	if fix {
 		return removeFix(1, qp)
 	}
 	return false
 }
 
+// Allocates an unlinked node, from the arena if the tree has one
+func (t *Tree[K, T]) newNode(key K, value T, parent *Node[K, T]) *Node[K, T] {
+	if t.nodes == nil {
+		return &Node[K, T]{Key: key, Value: value, Parent: parent, size: 1}
+	}
+	n := t.nodes.Alloc()
+	n.Key, n.Value, n.Parent, n.size = key, value, parent, 1
+	return n
+}
+
+// Returns the removed node to the arena if the tree has one
+func (t *Tree[K, T]) freeNode(n *Node[K, T]) {
+	if t.nodes != nil {
+		t.nodes.Free(n)
+	}
+}
+
 func putFix[K comparable, T any](c int8, t **Node[K, T]) bool {
 	s := *t
 	if s.b == 0 {
@@ -399,6 +438,8 @@ func rotate[K comparable, T any](c int8, s *Node[K, T]) *Node[K, T] {
 	r.Children[a^1] = s
 	r.Parent = s.Parent
 	s.Parent = r
+	s.resize()
+	r.resize()
 	return r
 }
 
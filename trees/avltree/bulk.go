@@ -0,0 +1,71 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// FromSorted instantiates an AVL tree with the custom comparator from keys that are already
+// in ascending order without duplicates and their corresponding values, in O(n) without any rotations.
+// Panics if the slices are of different lengths or the keys are not strictly ascending.
+func FromSorted[K comparable, T any](comparator utils.Comparator[K], keys []K, values []T) *Tree[K, T] {
+	if len(keys) != len(values) {
+		panic("Invalid values, should be as many as keys")
+	}
+	if !ascending(comparator, keys) {
+		panic("Invalid keys, should be in ascending order without duplicates")
+	}
+	t := NewWith[K, T](comparator)
+	t.build(keys, values)
+	return t
+}
+
+// FromSortedIterator instantiates an AVL tree with the custom comparator from the remaining elements
+// of the iterator, whose keys have to be in ascending order without duplicates, in O(n).
+// Panics if the keys are not strictly ascending.
+func FromSortedIterator[K comparable, T any](comparator utils.Comparator[K], it containers.IteratorWithKey[K, T]) *Tree[K, T] {
+	var keys []K
+	var values []T
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	return FromSorted(comparator, keys, values)
+}
+
+// build fills the empty tree with the sorted keys and values, splitting them at the middle key,
+// so that the sizes, and thus the heights, of the subtrees of every node differ by at most one.
+func (t *Tree[K, T]) build(keys []K, values []T) {
+	t.Root, _ = t.buildSubtree(keys, values, nil)
+	t.size = len(keys)
+	t.version++
+}
+
+// buildSubtree returns the root of the subtree and its height.
+func (t *Tree[K, T]) buildSubtree(keys []K, values []T, parent *Node[K, T]) (*Node[K, T], int) {
+	if len(keys) == 0 {
+		return nil, 0
+	}
+	middle := len(keys) / 2
+	n := t.newNode(keys[middle], values[middle], parent)
+	left, leftHeight := t.buildSubtree(keys[:middle], values[:middle], n)
+	right, rightHeight := t.buildSubtree(keys[middle+1:], values[middle+1:], n)
+	n.Children[0], n.Children[1] = left, right
+	n.b = int8(rightHeight - leftHeight)
+	n.resize()
+	return n, max(leftHeight, rightHeight) + 1
+}
+
+// ascending returns true if the keys are in ascending order without duplicates
+func ascending[K any](comparator utils.Comparator[K], keys []K) bool {
+	for i := 1; i < len(keys); i++ {
+		if comparator(keys[i-1], keys[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/internal/arena"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Tree[int, int], int] = (*Tree[int, int])(nil)
+
+// Clone returns a copy of the tree with the same structure and balance factors.
+// Runs in O(n) without any rebalancing.
+func (t *Tree[K, T]) Clone() *Tree[K, T] {
+	return t.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the tree where every value is passed through the given copy function.
+// Keys are copied by assignment. Runs in O(n) without any rebalancing.
+func (t *Tree[K, T]) DeepClone(copyValue func(value T) T) *Tree[K, T] {
+	clone := &Tree[K, T]{
+		Comparator: t.Comparator,
+		size:       t.size,
+	}
+	if t.nodes != nil {
+		clone.nodes = arena.New[Node[K, T]](t.nodes.ChunkSize())
+	}
+	clone.Root = clone.cloneNode(t.Root, nil, copyValue)
+	return clone
+}
+
+// Equal returns true if both trees hold the same keys and values in the same order.
+// Keys and values are compared with utils.DefaultEquality.
+func (t *Tree[K, T]) Equal(other *Tree[K, T]) bool {
+	if t.Size() != other.Size() {
+		return false
+	}
+	keyEqual, valueEqual := utils.DefaultEquality[K](), utils.DefaultEquality[T]()
+	for n1, n2 := t.Left(), other.Left(); n1 != nil && n2 != nil; n1, n2 = n1.Next(), n2.Next() {
+		if !keyEqual(n1.Key, n2.Key) || !valueEqual(n1.Value, n2.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Tree[K, T]) cloneNode(n *Node[K, T], parent *Node[K, T], copyValue func(value T) T) *Node[K, T] {
+	if n == nil {
+		return nil
+	}
+	clone := t.newNode(n.Key, copyValue(n.Value), parent)
+	clone.b, clone.size = n.b, n.size
+	clone.Children[0] = t.cloneNode(n.Children[0], clone, copyValue)
+	clone.Children[1] = t.cloneNode(n.Children[1], clone, copyValue)
+	return clone
+}
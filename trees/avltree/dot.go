@@ -0,0 +1,37 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/internal/dot"
+)
+
+// ToDOT outputs the structure of the tree in the Graphviz DOT language, with the balance factor
+// (height of the right subtree minus height of the left subtree) below the key of every node.
+// Render it with e.g. "dot -Tsvg tree.dot > tree.svg".
+func (t *Tree[K, T]) ToDOT() string {
+	graph := dot.New("AVLTree", "shape=circle")
+	if t.Root != nil {
+		outputDOT(graph, t.Root)
+	}
+	return graph.String()
+}
+
+func outputDOT[K comparable, T any](graph *dot.Graph, node *Node[K, T]) int {
+	id := graph.Node(fmt.Sprintf("%v\n%+d", node.Key, node.b))
+	if node.Children[0] == nil && node.Children[1] == nil {
+		return id
+	}
+	for _, child := range node.Children {
+		if child == nil {
+			graph.Placeholder(id)
+		} else {
+			graph.Edge(id, outputDOT(graph, child))
+		}
+	}
+	return id
+}
@@ -14,6 +14,7 @@ type Iterator[K comparable, T any] struct {
 	tree     *Tree[K, T]
 	node     *Node[K, T]
 	position position
+	version  int
 }
 
 type position byte
@@ -23,8 +24,9 @@ const (
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator panics with containers.ErrConcurrentModification if the tree is modified during iteration.
 func (tree *Tree[K, T]) Iterator() containers.ReverseIteratorWithKey[K, T] {
-	return &Iterator[K, T]{tree: tree, node: nil, position: begin}
+	return &Iterator[K, T]{tree: tree, node: nil, position: begin, version: tree.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -32,6 +34,10 @@ func (tree *Tree[K, T]) Iterator() containers.ReverseIteratorWithKey[K, T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Next() bool {
+	if iterator.position == begin {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	switch iterator.position {
 	case begin:
 		iterator.position = between
@@ -52,6 +58,10 @@ func (iterator *Iterator[K, T]) Next() bool {
 // If Prev() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Prev() bool {
+	if iterator.position == end {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	switch iterator.position {
 	case end:
 		iterator.position = between
@@ -70,6 +80,7 @@ func (iterator *Iterator[K, T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Value() T {
+	iterator.checkVersion()
 	if iterator.node == nil {
 		var empty T
 		return empty
@@ -80,6 +91,7 @@ func (iterator *Iterator[K, T]) Value() T {
 // Key returns the current element's key.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Key() K {
+	iterator.checkVersion()
 	if iterator.node == nil {
 		var empty K
 		return empty
@@ -90,6 +102,7 @@ func (iterator *Iterator[K, T]) Key() K {
 // Node returns the current element's node.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Node() *Node[K, T] {
+	iterator.checkVersion()
 	return iterator.node
 }
 
@@ -98,6 +111,7 @@ func (iterator *Iterator[K, T]) Node() *Node[K, T] {
 func (iterator *Iterator[K, T]) Begin() {
 	iterator.node = nil
 	iterator.position = begin
+	iterator.version = iterator.tree.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -105,6 +119,7 @@ func (iterator *Iterator[K, T]) Begin() {
 func (iterator *Iterator[K, T]) End() {
 	iterator.node = nil
 	iterator.position = end
+	iterator.version = iterator.tree.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -150,3 +165,10 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// checkVersion panics if the tree was modified since the iterator was created or reset.
+func (iterator *Iterator[K, T]) checkVersion() {
+	if iterator.version != iterator.tree.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"github.com/ugurcsen/gods-generic/internal/arena"
+)
+
+// Split, Union, Intersection and Difference are built on join, which links two trees and a middle node
+// in time proportional to the difference of their heights, as described in
+// "Just Join for Parallel Ordered Sets" by Blelloch, Ferizovic and Sun.
+// Subtrees are passed around detached, i.e. without parent, together with their height,
+// from which the heights of the children follow by the balance factor.
+
+// Split moves the nodes with keys smaller than the given key to the left tree and the other nodes to the right tree
+// in O(log n) and leaves the tree empty. Both trees use the comparator of the tree.
+func (t *Tree[K, T]) Split(key K) (left *Tree[K, T], right *Tree[K, T]) {
+	left, right = t.newTree(), t.newTree()
+	left.nodes, t.nodes = t.nodes, left.nodes
+	leftRoot, _, found, rightRoot, rightHeight := t.split(t.Root, height(t.Root), key)
+	if found != nil {
+		rightRoot, _ = join(nil, 0, found, rightRoot, rightHeight)
+	}
+	left.setRoot(leftRoot)
+	right.setRoot(rightRoot)
+	t.setRoot(nil)
+	return left, right
+}
+
+// Union moves the nodes of the other tree into the tree and leaves the other tree empty.
+// The tree keeps its values of the keys found in both trees. Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (t *Tree[K, T]) Union(other *Tree[K, T]) {
+	if other == t {
+		return
+	}
+	root, _ := t.union(t.Root, height(t.Root), other.Root, height(other.Root))
+	t.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Intersection removes the nodes whose keys are not in the other tree from the tree and leaves the other tree empty.
+// Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (t *Tree[K, T]) Intersection(other *Tree[K, T]) {
+	if other == t {
+		return
+	}
+	root, _ := t.intersection(t.Root, height(t.Root), other.Root, height(other.Root))
+	t.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Difference removes the nodes whose keys are in the other tree from the tree and leaves the other tree empty.
+// Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (t *Tree[K, T]) Difference(other *Tree[K, T]) {
+	if other == t {
+		t.Clear()
+		return
+	}
+	root, _ := t.difference(t.Root, height(t.Root), other.Root, height(other.Root))
+	t.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Returns an empty tree with the comparator of the tree and an arena of the same chunk size if the tree has one
+func (t *Tree[K, T]) newTree() *Tree[K, T] {
+	other := &Tree[K, T]{Comparator: t.Comparator}
+	if t.nodes != nil {
+		other.nodes = arena.New[Node[K, T]](t.nodes.ChunkSize())
+	}
+	return other
+}
+
+// Replaces the nodes of the tree by the detached subtree
+func (t *Tree[K, T]) setRoot(root *Node[K, T]) {
+	t.Root = root
+	t.size = root.Size()
+	if root != nil {
+		root.Parent = nil
+	}
+	t.version++
+}
+
+// split splits the subtree into the subtrees with the keys smaller and larger than the key
+// and returns them with their heights and the detached node with the key, if any.
+func (t *Tree[K, T]) split(n *Node[K, T], h int, key K) (*Node[K, T], int, *Node[K, T], *Node[K, T], int) {
+	if n == nil {
+		return nil, 0, nil, nil, 0
+	}
+	left, leftHeight, right, rightHeight := detach(n, h)
+	c := t.Comparator(key, n.Key)
+	switch {
+	case c < 0:
+		smaller, smallerHeight, found, larger, largerHeight := t.split(left, leftHeight, key)
+		larger, largerHeight = join(larger, largerHeight, n, right, rightHeight)
+		return smaller, smallerHeight, found, larger, largerHeight
+	case c > 0:
+		smaller, smallerHeight, found, larger, largerHeight := t.split(right, rightHeight, key)
+		smaller, smallerHeight = join(left, leftHeight, n, smaller, smallerHeight)
+		return smaller, smallerHeight, found, larger, largerHeight
+	default:
+		return left, leftHeight, n, right, rightHeight
+	}
+}
+
+// union merges the subtrees, freeing the nodes of the second one whose keys are in the first one.
+func (t *Tree[K, T]) union(n1 *Node[K, T], h1 int, n2 *Node[K, T], h2 int) (*Node[K, T], int) {
+	if n1 == nil {
+		return n2, h2
+	}
+	if n2 == nil {
+		return n1, h1
+	}
+	left1, leftHeight1, right1, rightHeight1 := detach(n1, h1)
+	left2, leftHeight2, found, right2, rightHeight2 := t.split(n2, h2, n1.Key)
+	if found != nil {
+		t.freeNode(found)
+	}
+	left, leftHeight := t.union(left1, leftHeight1, left2, leftHeight2)
+	right, rightHeight := t.union(right1, rightHeight1, right2, rightHeight2)
+	return join(left, leftHeight, n1, right, rightHeight)
+}
+
+// intersection keeps the nodes of the first subtree whose keys are in the second one.
+func (t *Tree[K, T]) intersection(n1 *Node[K, T], h1 int, n2 *Node[K, T], h2 int) (*Node[K, T], int) {
+	if n1 == nil || n2 == nil {
+		return nil, 0
+	}
+	left1, leftHeight1, right1, rightHeight1 := detach(n1, h1)
+	left2, leftHeight2, found, right2, rightHeight2 := t.split(n2, h2, n1.Key)
+	left, leftHeight := t.intersection(left1, leftHeight1, left2, leftHeight2)
+	right, rightHeight := t.intersection(right1, rightHeight1, right2, rightHeight2)
+	if found == nil {
+		t.freeNode(n1)
+		return merge(left, leftHeight, right, rightHeight)
+	}
+	t.freeNode(found)
+	return join(left, leftHeight, n1, right, rightHeight)
+}
+
+// difference keeps the nodes of the first subtree whose keys are not in the second one.
+func (t *Tree[K, T]) difference(n1 *Node[K, T], h1 int, n2 *Node[K, T], h2 int) (*Node[K, T], int) {
+	if n1 == nil || n2 == nil {
+		return n1, h1
+	}
+	left2, leftHeight2, right2, rightHeight2 := detach(n2, h2)
+	left1, leftHeight1, found, right1, rightHeight1 := t.split(n1, h1, n2.Key)
+	if found != nil {
+		t.freeNode(found)
+	}
+	left, leftHeight := t.difference(left1, leftHeight1, left2, leftHeight2)
+	right, rightHeight := t.difference(right1, rightHeight1, right2, rightHeight2)
+	return merge(left, leftHeight, right, rightHeight)
+}
+
+// join links the subtrees to the node, whose key is larger than the keys of the left and smaller than the keys of
+// the right subtree, and returns the root of the joined subtree and its height.
+func join[K comparable, T any](left *Node[K, T], leftHeight int, n *Node[K, T], right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	var root *Node[K, T]
+	var h int
+	switch {
+	case leftHeight > rightHeight+1:
+		root, h = joinRight(left, leftHeight, n, right, rightHeight)
+	case rightHeight > leftHeight+1:
+		root, h = joinLeft(left, leftHeight, n, right, rightHeight)
+	default:
+		root, h = link(n, left, leftHeight, right, rightHeight)
+	}
+	root.Parent = nil
+	return root, h
+}
+
+// joinRight descends the right spine of the higher left subtree down to a node at most one higher than the right
+// subtree, links both below the node in its place and rebalances the spine on the way up by single or double rotations.
+func joinRight[K comparable, T any](left *Node[K, T], leftHeight int, n *Node[K, T], right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	outer, outerHeight, inner, innerHeight := detach(left, leftHeight)
+	if innerHeight <= rightHeight+1 {
+		joined, joinedHeight := link(n, inner, innerHeight, right, rightHeight)
+		if joinedHeight <= outerHeight+1 {
+			return link(left, outer, outerHeight, joined, joinedHeight)
+		}
+		innerLeft, innerLeftHeight, innerRight, innerRightHeight := detach(inner, innerHeight)
+		l, lh := link(left, outer, outerHeight, innerLeft, innerLeftHeight)
+		r, rh := link(n, innerRight, innerRightHeight, right, rightHeight)
+		return link(inner, l, lh, r, rh)
+	}
+	joined, joinedHeight := joinRight(inner, innerHeight, n, right, rightHeight)
+	if joinedHeight <= outerHeight+1 {
+		return link(left, outer, outerHeight, joined, joinedHeight)
+	}
+	joinedLeft, joinedLeftHeight, joinedRight, joinedRightHeight := detach(joined, joinedHeight)
+	l, lh := link(left, outer, outerHeight, joinedLeft, joinedLeftHeight)
+	return link(joined, l, lh, joinedRight, joinedRightHeight)
+}
+
+// joinLeft is the mirror image of joinRight for a higher right subtree.
+func joinLeft[K comparable, T any](left *Node[K, T], leftHeight int, n *Node[K, T], right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	inner, innerHeight, outer, outerHeight := detach(right, rightHeight)
+	if innerHeight <= leftHeight+1 {
+		joined, joinedHeight := link(n, left, leftHeight, inner, innerHeight)
+		if joinedHeight <= outerHeight+1 {
+			return link(right, joined, joinedHeight, outer, outerHeight)
+		}
+		innerLeft, innerLeftHeight, innerRight, innerRightHeight := detach(inner, innerHeight)
+		l, lh := link(n, left, leftHeight, innerLeft, innerLeftHeight)
+		r, rh := link(right, innerRight, innerRightHeight, outer, outerHeight)
+		return link(inner, l, lh, r, rh)
+	}
+	joined, joinedHeight := joinLeft(left, leftHeight, n, inner, innerHeight)
+	if joinedHeight <= outerHeight+1 {
+		return link(right, joined, joinedHeight, outer, outerHeight)
+	}
+	joinedLeft, joinedLeftHeight, joinedRight, joinedRightHeight := detach(joined, joinedHeight)
+	r, rh := link(right, joinedRight, joinedRightHeight, outer, outerHeight)
+	return link(joined, joinedLeft, joinedLeftHeight, r, rh)
+}
+
+// merge joins the subtrees, where all keys of the left subtree are smaller than the keys of the right subtree,
+// using the maximum node of the left subtree as the middle node.
+func merge[K comparable, T any](left *Node[K, T], leftHeight int, right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	if left == nil {
+		return right, rightHeight
+	}
+	if right == nil {
+		return left, leftHeight
+	}
+	left, leftHeight, last := splitLast(left, leftHeight)
+	return join(left, leftHeight, last, right, rightHeight)
+}
+
+// splitLast detaches the maximum node from the subtree and returns the rest of the subtree and its height.
+func splitLast[K comparable, T any](n *Node[K, T], h int) (*Node[K, T], int, *Node[K, T]) {
+	left, leftHeight, right, rightHeight := detach(n, h)
+	if right == nil {
+		return left, leftHeight, n
+	}
+	rest, restHeight, last := splitLast(right, rightHeight)
+	root, rootHeight := join(left, leftHeight, n, rest, restHeight)
+	return root, rootHeight, last
+}
+
+// detach unlinks the children of the node with the given height and returns them with their heights.
+func detach[K comparable, T any](n *Node[K, T], h int) (*Node[K, T], int, *Node[K, T], int) {
+	left, right := n.Children[0], n.Children[1]
+	n.Children[0], n.Children[1] = nil, nil
+	if left != nil {
+		left.Parent = nil
+	}
+	if right != nil {
+		right.Parent = nil
+	}
+	leftHeight, rightHeight := h-1, h-1
+	switch {
+	case n.b < 0:
+		rightHeight--
+	case n.b > 0:
+		leftHeight--
+	}
+	return left, leftHeight, right, rightHeight
+}
+
+// link sets the children of the node, whose heights differ by at most one, updates its size and returns the node and
+// its height.
+func link[K comparable, T any](n *Node[K, T], left *Node[K, T], leftHeight int, right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	n.Children[0], n.Children[1] = left, right
+	if left != nil {
+		left.Parent = n
+	}
+	if right != nil {
+		right.Parent = n
+	}
+	n.b = int8(rightHeight - leftHeight)
+	n.resize()
+	return n, max(leftHeight, rightHeight) + 1
+}
+
+// height returns the height of the subtree by descending along its higher children.
+func height[K comparable, T any](n *Node[K, T]) int {
+	h := 0
+	for ; n != nil; h++ {
+		if n.b < 0 {
+			n = n.Children[0]
+		} else {
+			n = n.Children[1]
+		}
+	}
+	return h
+}
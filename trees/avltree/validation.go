@@ -0,0 +1,66 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package avltree
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/trees"
+)
+
+// Validate checks the invariants of the AVL tree and returns an error wrapping trees.ErrInvalid
+// describing the first violation found, or nil if the tree is valid:
+// keys are in ascending order, parent links are consistent, the heights of the subtrees of every node differ
+// by at most one and match its balance factor, and the sizes of the tree and of every subtree match their node
+// counts.
+// Meant for debugging and testing, it visits every node.
+func (t *Tree[K, T]) Validate() error {
+	if t.Root != nil && t.Root.Parent != nil {
+		return fmt.Errorf("%w: root %v has parent %v", trees.ErrInvalid, t.Root, t.Root.Parent)
+	}
+	if _, err := t.validate(t.Root, nil, nil); err != nil {
+		return err
+	}
+	if t.Root.Size() != t.size {
+		return fmt.Errorf("%w: tree has %d nodes but size %d", trees.ErrInvalid, t.Root.Size(), t.size)
+	}
+	return nil
+}
+
+// validate checks the subtree whose keys have to be between the keys of the lower and upper nodes, if any,
+// and returns its height.
+func (t *Tree[K, T]) validate(n, lower, upper *Node[K, T]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if lower != nil && t.Comparator(lower.Key, n.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not after %v", trees.ErrInvalid, n, lower)
+	}
+	if upper != nil && t.Comparator(n.Key, upper.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not before %v", trees.ErrInvalid, n, upper)
+	}
+	for _, child := range n.Children {
+		if child != nil && child.Parent != n {
+			return 0, fmt.Errorf("%w: child %v of node %v has parent %v", trees.ErrInvalid, child, n, child.Parent)
+		}
+	}
+	leftHeight, err := t.validate(n.Children[0], lower, n)
+	if err != nil {
+		return 0, err
+	}
+	rightHeight, err := t.validate(n.Children[1], n, upper)
+	if err != nil {
+		return 0, err
+	}
+	if balance := rightHeight - leftHeight; balance < -1 || balance > 1 {
+		return 0, fmt.Errorf("%w: node %v has subtree heights %d and %d", trees.ErrInvalid, n, leftHeight, rightHeight)
+	} else if balance != int(n.b) {
+		return 0, fmt.Errorf("%w: node %v has balance factor %d but subtree heights %d and %d", trees.ErrInvalid, n, n.b, leftHeight, rightHeight)
+	}
+	if size := 1 + n.Children[0].Size() + n.Children[1].Size(); n.size != size {
+		return 0, fmt.Errorf("%w: node %v has %d nodes but size %d", trees.ErrInvalid, n, size, n.size)
+	}
+	return max(leftHeight, rightHeight) + 1, nil
+}
@@ -6,6 +6,10 @@
 //
 // Comparator defines this heap as either min or max heap.
 //
+// The arity of the heap (number of children of each node) is configurable, i.e. it can be used as a d-ary heap.
+// Higher arity makes the heap shallower, which speeds up pushes and makes pops more cache-friendly,
+// at the cost of more comparisons per level when popping.
+//
 // Structure is not thread safe.
 //
 // References: http://en.wikipedia.org/wiki/Binary_heap
@@ -19,44 +23,139 @@ import (
 	"strings"
 )
 
-// Assert Tree implementation
-var _ trees.Tree[int] = (*Heap[int])(nil)
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
 
 // Heap holds elements in an array-list
-type Heap[T comparable] struct {
+type Heap[T any] struct {
 	list       *arraylist.List[T]
+	arity      int
 	Comparator utils.Comparator[T]
+	version    int // incremented on every modification, used by iterators to detect concurrent modification
 }
 
 // NewWith instantiates a new empty heap tree with the custom comparator.
-func NewWith[T comparable](comparator utils.Comparator[T]) *Heap[T] {
-	return &Heap[T]{list: arraylist.New[T](), Comparator: comparator}
+func NewWith[T any](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{list: arraylist.New[T](), arity: 2, Comparator: comparator}
+}
+
+// NewWithArity instantiates a new empty d-ary heap with the custom comparator, where each node has up to arity children.
+// Arity less than 2 is treated as 2.
+func NewWithArity[T any](arity int, comparator utils.Comparator[T]) *Heap[T] {
+	if arity < 2 {
+		arity = 2
+	}
+	return &Heap[T]{list: arraylist.New[T](), arity: arity, Comparator: comparator}
+}
+
+// NewFrom instantiates a new heap with the custom comparator holding the passed values.
+// The heap is built in O(n) and the passed slice is not modified.
+func NewFrom[T any](values []T, comparator utils.Comparator[T]) *Heap[T] {
+	heap := &Heap[T]{list: arraylist.New[T](values...), arity: 2, Comparator: comparator}
+	heap.heapify()
+	return heap
 }
 
 // NewWithNumberComparator instantiates a new empty heap with the IntComparator, i.e. elements are of type int.
 func NewWithNumberComparator[T utils.ComparableNumber]() *Heap[T] {
-	return &Heap[T]{list: arraylist.New[T](), Comparator: utils.NumberComparator[T]}
+	return &Heap[T]{list: arraylist.New[T](), arity: 2, Comparator: utils.NumberComparator[T]}
 }
 
 // NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
 func NewWithStringComparator() *Heap[string] {
-	return &Heap[string]{list: arraylist.New[string](), Comparator: utils.StringComparator}
+	return &Heap[string]{list: arraylist.New[string](), arity: 2, Comparator: utils.StringComparator}
 }
 
 // Push adds a value onto the heap and bubbles it up accordingly.
+// Pushing multiple values rebuilds the heap in O(n).
 func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	heap.version++
 	if len(values) == 1 {
 		heap.list.Add(values[0])
 		heap.bubbleUp()
 	} else {
-		// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
-		for _, value := range values {
-			heap.list.Add(value)
-		}
-		size := heap.list.Size()/2 + 1
-		for i := size; i >= 0; i-- {
-			heap.bubbleDownIndex(i)
-		}
+		heap.list.Add(values...)
+		heap.heapify()
+	}
+}
+
+// PushPop pushes the value onto the heap and then pops the top element, which is returned.
+// It is more efficient than calling Push followed by Pop.
+func (heap *Heap[T]) PushPop(value T) T {
+	top, ok := heap.list.Get(0)
+	if !ok || heap.Comparator(value, top) <= 0 {
+		return value
+	}
+	heap.version++
+	heap.list.Set(0, value)
+	heap.bubbleDown()
+	return top
+}
+
+// Replace pops the top element and then pushes the value onto the heap.
+// It is more efficient than calling Pop followed by Push.
+// Returns the popped element and true, unless the heap was empty and there was nothing to pop (the value is pushed anyway).
+func (heap *Heap[T]) Replace(value T) (top T, ok bool) {
+	top, ok = heap.list.Get(0)
+	if !ok {
+		heap.Push(value)
+		return
+	}
+	heap.version++
+	heap.list.Set(0, value)
+	heap.bubbleDown()
+	return
+}
+
+// Merge adds all elements of the other heap onto this heap in O(n+m).
+// The other heap is not modified.
+func (heap *Heap[T]) Merge(other *Heap[T]) {
+	if other.Empty() {
+		return
+	}
+	heap.version++
+	heap.list.Add(other.list.Values()...)
+	heap.heapify()
+}
+
+// IndexOfFunc returns the position of the first element within the heap's underlying array that satisfies the given
+// function, or -1 if there is none. Such positions can be used with Remove and Fix.
+// Note that positions differ from the indices of the heap's iterator.
+func (heap *Heap[T]) IndexOfFunc(f func(value T) bool) int {
+	return heap.list.IndexOfFunc(f)
+}
+
+// Remove removes the element at the given position within the heap's underlying array (see IndexOfFunc) and returns it.
+// Second return parameter is true, unless the position was out of bounds and there was nothing to remove.
+func (heap *Heap[T]) Remove(index int) (value T, ok bool) {
+	value, ok = heap.list.Get(index)
+	if !ok {
+		return
+	}
+	heap.version++
+	lastIndex := heap.list.Size() - 1
+	heap.list.Swap(index, lastIndex)
+	heap.list.Remove(lastIndex)
+	if index < lastIndex {
+		heap.Fix(index)
+	}
+	return
+}
+
+// Fix re-establishes the heap order after the element at the given position within the heap's underlying array
+// (see IndexOfFunc) changed in place, e.g. through a pointer. Does nothing if the position is out of bounds.
+func (heap *Heap[T]) Fix(index int) {
+	if !heap.withinRange(index) {
+		return
+	}
+	heap.version++
+	if index > 0 && heap.compare(index, (index-1)/heap.arity) < 0 {
+		heap.bubbleUpIndex(index)
+	} else {
+		heap.bubbleDownIndex(index)
 	}
 }
 
@@ -67,6 +166,7 @@ func (heap *Heap[T]) Pop() (value T, ok bool) {
 	if !ok {
 		return
 	}
+	heap.version++
 	lastIndex := heap.list.Size() - 1
 	heap.list.Swap(0, lastIndex)
 	heap.list.Remove(lastIndex)
@@ -92,7 +192,9 @@ func (heap *Heap[T]) Size() int {
 
 // Clear removes all elements from the heap.
 func (heap *Heap[T]) Clear() {
+	heap.version++
 	heap.list.Clear()
+
 }
 
 // Values returns all elements in the heap.
@@ -125,21 +227,17 @@ func (heap *Heap[T]) bubbleDown() {
 // of the heap in its correct place so that the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleDownIndex(index int) {
 	size := heap.list.Size()
-	for leftIndex := index<<1 + 1; leftIndex < size; leftIndex = index<<1 + 1 {
-		rightIndex := index<<1 + 2
-		smallerIndex := leftIndex
-		leftValue, _ := heap.list.Get(leftIndex)
-		rightValue, _ := heap.list.Get(rightIndex)
-		if rightIndex < size && heap.Comparator(leftValue, rightValue) > 0 {
-			smallerIndex = rightIndex
+	for firstChild := index*heap.arity + 1; firstChild < size; firstChild = index*heap.arity + 1 {
+		smallerIndex := firstChild
+		for child := firstChild + 1; child < firstChild+heap.arity && child < size; child++ {
+			if heap.compare(smallerIndex, child) > 0 {
+				smallerIndex = child
+			}
 		}
-		indexValue, _ := heap.list.Get(index)
-		smallerValue, _ := heap.list.Get(smallerIndex)
-		if heap.Comparator(indexValue, smallerValue) > 0 {
-			heap.list.Swap(index, smallerIndex)
-		} else {
+		if heap.compare(index, smallerIndex) <= 0 {
 			break
 		}
+		heap.list.Swap(index, smallerIndex)
 		index = smallerIndex
 	}
 }
@@ -148,11 +246,15 @@ func (heap *Heap[T]) bubbleDownIndex(index int) {
 // element (i.e. last element in the list) in its correct place so that
 // the heap maintains the min/max-heap order property.
 func (heap *Heap[T]) bubbleUp() {
-	index := heap.list.Size() - 1
-	for parentIndex := (index - 1) >> 1; index > 0; parentIndex = (index - 1) >> 1 {
-		indexValue, _ := heap.list.Get(index)
-		parentValue, _ := heap.list.Get(parentIndex)
-		if heap.Comparator(parentValue, indexValue) <= 0 {
+	heap.bubbleUpIndex(heap.list.Size() - 1)
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleUpIndex(index int) {
+	for index > 0 {
+		parentIndex := (index - 1) / heap.arity
+		if heap.compare(parentIndex, index) <= 0 {
 			break
 		}
 		heap.list.Swap(index, parentIndex)
@@ -160,6 +262,20 @@ func (heap *Heap[T]) bubbleUp() {
 	}
 }
 
+// Restores the heap order of the whole list bottom-up in O(n).
+// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+func (heap *Heap[T]) heapify() {
+	for index := (heap.list.Size() - 2) / heap.arity; index >= 0; index-- {
+		heap.bubbleDownIndex(index)
+	}
+}
+
+func (heap *Heap[T]) compare(index1, index2 int) int {
+	value1, _ := heap.list.Get(index1)
+	value2, _ := heap.list.Get(index2)
+	return heap.Comparator(value1, value2)
+}
+
 // Check that the index is within bounds of the list
 func (heap *Heap[T]) withinRange(index int) bool {
 	return index >= 0 && index < heap.list.Size()
@@ -6,6 +6,10 @@ package binaryheap
 
 import (
 	"encoding/json"
+	"errors"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/trees"
+	"github.com/ugurcsen/gods-generic/utils"
 	"math/rand"
 	"strings"
 	"testing"
@@ -419,6 +423,304 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapClone(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3, 1, 2)
+
+	clone := heap.Clone()
+	clone.Push(0)
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	for _, expectedValue := range []int{0, 1, 2, 3} {
+		if actualValue, ok := clone.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	deepClone := heap.DeepClone(func(value int) int { return value * 10 })
+	for _, expectedValue := range []int{10, 20, 30} {
+		if actualValue, ok := deepClone.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapEqual(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3)
+	heap.Push(1)
+	heap.Push(2)
+	other := NewWithNumberComparator[int]()
+	other.Push(1)
+	other.Push(2)
+	other.Push(3)
+	if actualValue := heap.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Pop()
+	if actualValue := heap.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Push(4)
+	if actualValue := heap.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestBinaryHeapEqualTiedElements(t *testing.T) {
+	type item struct {
+		priority int
+		name     string
+	}
+	byPriority := func(a, b item) int { return utils.NumberComparator(a.priority, b.priority) }
+	items := []item{{1, "a"}, {2, "b"}, {1, "c"}, {2, "d"}, {1, "e"}, {3, "f"}}
+	for shift := 0; shift < len(items); shift++ {
+		heap, other := NewWith(byPriority), NewWith(byPriority)
+		for index := range items {
+			heap.Push(items[index])
+			other.Push(items[(index+shift)%len(items)])
+		}
+		if actualValue := heap.Equal(other); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		other.Pop()
+		other.Push(item{1, "x"}) // tied with the popped item, but not equal to it
+		if actualValue := heap.Equal(other); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	}
+}
+
+func TestBinaryHeapNewFrom(t *testing.T) {
+	values := []int{15, 20, 3, 1, 2}
+	heap := NewFrom(values, utils.NumberComparator[int])
+	if actualValue, expectedValue := values[0], 15; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := heap.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, expectedValue := range []int{1, 2, 3, 15, 20} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	heap = NewFrom([]int(nil), utils.NumberComparator[int])
+	if actualValue, expectedValue := heap.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapArity(t *testing.T) {
+	for _, arity := range []int{0, 3, 4, 8} {
+		heap := NewWithArity(arity, utils.NumberComparator[int])
+		for i := 0; i < 1000; i++ {
+			heap.Push(rand.Intn(100))
+		}
+		heap.Push(rand.Perm(100)...)
+		values := heap.Values()
+		sorted := append([]int(nil), values...)
+		utils.Sort(sorted, heap.Comparator)
+		if actualValue, expectedValue := values[0], sorted[0]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := len(values), 1100; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		clone := heap.Clone()
+		for _, expectedValue := range sorted {
+			if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+		if actualValue, expectedValue := clone.arity, heap.arity; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapPushPopReplace(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	if actualValue, expectedValue := heap.PushPop(3), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := heap.Replace(5); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	heap.Push(7, 9)
+	if actualValue, expectedValue := heap.PushPop(1), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := heap.PushPop(8), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := heap.Replace(2); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, expectedValue := range []int{2, 8, 9} {
+		if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapMerge(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(5, 1, 9)
+	other := NewWithArity(3, utils.NumberComparator[int])
+	other.Push(4, 8, 2, 6)
+	heap.Merge(other)
+	if actualValue, expectedValue := other.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, expectedValue := range []int{1, 2, 4, 5, 6, 8, 9} {
+		if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestBinaryHeapRemoveAndFix(t *testing.T) {
+	type Task struct {
+		name     string
+		priority int
+	}
+	heap := NewWith(func(a, b *Task) int { return a.priority - b.priority })
+	tasks := map[string]*Task{}
+	for i, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		tasks[name] = &Task{name, i}
+		heap.Push(tasks[name])
+	}
+	if _, ok := heap.Remove(heap.Size()); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	index := heap.IndexOfFunc(func(task *Task) bool { return task.name == "c" })
+	if actualValue, ok := heap.Remove(index); actualValue != tasks["c"] || !ok {
+		t.Errorf("Got %v expected %v", actualValue, tasks["c"])
+	}
+	if actualValue, expectedValue := heap.IndexOfFunc(func(task *Task) bool { return task.name == "c" }), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tasks["f"].priority = -1
+	heap.Fix(heap.IndexOfFunc(func(task *Task) bool { return task == tasks["f"] }))
+	tasks["a"].priority = 10
+	heap.Fix(heap.IndexOfFunc(func(task *Task) bool { return task == tasks["a"] }))
+	heap.Fix(-1)
+	var names []string
+	for !heap.Empty() {
+		task, _ := heap.Pop()
+		names = append(names, task.name)
+	}
+	if actualValue, expectedValue := strings.Join(names, ""), "fbdega"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBinaryHeapRemoveRandom(t *testing.T) {
+	for _, arity := range []int{2, 5} {
+		heap := NewWithArity(arity, utils.NumberComparator[int])
+		heap.Push(rand.Perm(500)...)
+		for i := 0; i < 250; i++ {
+			heap.Remove(rand.Intn(heap.Size()))
+		}
+		prev, _ := heap.Pop()
+		for !heap.Empty() {
+			curr, _ := heap.Pop()
+			if prev > curr {
+				t.Errorf("Heap property invalidated. prev: %v current: %v", prev, curr)
+			}
+			prev = curr
+		}
+	}
+}
+
+func TestBinaryHeapIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3, 1, 2)
+	it := heap.Iterator()
+	it.Next()
+	heap.Push(0)
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Value() })
+
+	it.Begin()
+	heap.Pop() // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Value(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Fix(0)
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestBinaryHeapToDOT(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	if actualValue, expectedValue := heap.ToDOT(), "digraph \"BinaryHeap\" {\n\tnode [shape=circle, ordering=out];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Push(3, 1, 2, 4)
+	dot := heap.ToDOT()
+	for _, expectedValue := range []string{
+		`n0 [label="1", xlabel=0];`,
+		`n1 [label="3", xlabel=1];`,
+		`n2 [label="2", xlabel=2];`,
+		`n3 [label="4", xlabel=3];`,
+		"n0 -> n1;",
+		"n0 -> n2;",
+		"n1 -> n3;",
+	} {
+		if !strings.Contains(dot, expectedValue) {
+			t.Errorf("Got %v expected to contain %v", dot, expectedValue)
+		}
+	}
+
+	heap = NewWithArity(3, utils.NumberComparator[int])
+	heap.Push(1, 2, 3, 4, 5)
+	if dot := heap.ToDOT(); !strings.Contains(dot, "n0 -> n3;") || !strings.Contains(dot, "n1 -> n4;") {
+		t.Errorf("Got %v expected 3-ary edges", dot)
+	}
+}
+
+func TestBinaryHeapValidate(t *testing.T) {
+	for _, arity := range []int{2, 3, 4} {
+		heap := NewWithArity(arity, utils.NumberComparator[int])
+		if err := heap.Validate(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 1000; i++ {
+			switch r.Intn(4) {
+			case 0:
+				heap.Pop()
+			case 1:
+				heap.Remove(r.Intn(heap.Size() + 1))
+			default:
+				heap.Push(r.Intn(100))
+			}
+			if err := heap.Validate(); err != nil {
+				t.Fatalf("Got error %v after %d operations with arity %d", err, i+1, arity)
+			}
+		}
+		heap.list.Set(heap.Size()-1, -1)
+		if err := heap.Validate(); !errors.Is(err, trees.ErrInvalid) {
+			t.Errorf("Got %v expected %v", err, trees.ErrInvalid)
+		}
+	}
+}
+
 func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
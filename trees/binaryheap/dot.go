@@ -0,0 +1,23 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binaryheap
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/internal/dot"
+)
+
+// ToDOT outputs the structure of the heap in the Graphviz DOT language, with the index of every value next to it.
+// Render it with e.g. "dot -Tsvg heap.dot > heap.svg".
+func (heap *Heap[T]) ToDOT() string {
+	graph := dot.New("BinaryHeap", "shape=circle, ordering=out")
+	for index, value := range heap.list.Values() {
+		graph.Node(fmt.Sprintf("%v", value), fmt.Sprintf("xlabel=%d", index))
+		if index > 0 {
+			graph.Edge((index-1)/heap.arity, index)
+		}
+	}
+	return graph.String()
+}
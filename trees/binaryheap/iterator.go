@@ -12,14 +12,16 @@ import (
 var _ containers.ReverseIteratorWithIndex[int] = (*Iterator[int])(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
-type Iterator[T comparable] struct {
-	heap  *Heap[T]
-	index int
+type Iterator[T any] struct {
+	heap    *Heap[T]
+	index   int
+	version int
 }
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator panics with containers.ErrConcurrentModification if the heap is modified during iteration.
 func (heap *Heap[T]) Iterator() Iterator[T] {
-	return Iterator[T]{heap: heap, index: -1}
+	return Iterator[T]{heap: heap, index: -1, version: heap.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,6 +29,10 @@ func (heap *Heap[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.index == -1 {
+		iterator.version = iterator.heap.version
+	}
+	iterator.checkVersion()
 	if iterator.index < iterator.heap.Size() {
 		iterator.index++
 	}
@@ -37,6 +43,7 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	iterator.checkVersion()
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -46,7 +53,8 @@ func (iterator *Iterator[T]) Prev() bool {
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	start, end := evaluateRange(iterator.index)
+	iterator.checkVersion()
+	start, end := evaluateRange(iterator.index, iterator.heap.arity)
 	if end > iterator.heap.Size() {
 		end = iterator.heap.Size()
 	}
@@ -72,12 +80,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.version = iterator.heap.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.heap.Size()
+	iterator.version = iterator.heap.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -124,20 +134,20 @@ func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
 	return false
 }
 
-// numOfBits counts the number of bits of an int
-func numOfBits(n int) uint {
-	var count uint
-	for n != 0 {
-		count++
-		n >>= 1
+// evaluateRange evaluates the index range [start,end) of same level nodes in the heap as the index,
+// where each level holds arity times as many nodes as the previous one
+func evaluateRange(index int, arity int) (start int, end int) {
+	width := 1
+	for end = 1; end <= index; end += width {
+		start = end
+		width *= arity
 	}
-	return count
+	return
 }
 
-// evaluateRange evaluates the index range [start,end) of same level nodes in the heap as the index
-func evaluateRange(index int) (start int, end int) {
-	bits := numOfBits(index+1) - 1
-	start = 1<<bits - 1
-	end = start + 1<<bits
-	return
+// checkVersion panics if the heap was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) checkVersion() {
+	if iterator.version != iterator.heap.version {
+		panic(containers.ErrConcurrentModification)
+	}
 }
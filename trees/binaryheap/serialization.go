@@ -19,7 +19,9 @@ func (heap *Heap[T]) ToJSON() ([]byte, error) {
 
 // FromJSON populates the heap from the input JSON representation.
 func (heap *Heap[T]) FromJSON(data []byte) error {
+	heap.version++
 	return heap.list.FromJSON(data)
+
 }
 
 // UnmarshalJSON @implements json.Unmarshaler
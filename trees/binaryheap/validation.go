@@ -0,0 +1,24 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binaryheap
+
+import (
+	"fmt"
+	"github.com/ugurcsen/gods-generic/trees"
+)
+
+// Validate checks the heap order, i.e. that no value is ordered before its parent by the comparator,
+// and returns an error wrapping trees.ErrInvalid describing the first violation found, or nil if the heap is valid.
+// Meant for debugging and testing, it visits every value.
+func (heap *Heap[T]) Validate() error {
+	for index := 1; index < heap.list.Size(); index++ {
+		if parentIndex := (index - 1) / heap.arity; heap.compare(parentIndex, index) > 0 {
+			parent, _ := heap.list.Get(parentIndex)
+			value, _ := heap.list.Get(index)
+			return fmt.Errorf("%w: value %v at index %d is ordered before its parent %v at index %d", trees.ErrInvalid, value, index, parent, parentIndex)
+		}
+	}
+	return nil
+}
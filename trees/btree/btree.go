@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ugurcsen/gods-generic/internal/arena"
 	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/utils"
 )
@@ -30,10 +31,13 @@ var _ trees.Tree[int] = (*Tree[int, int])(nil)
 
 // Tree holds elements of the B-tree
 type Tree[K comparable, T any] struct {
-	Root       *Node[K, T]         // Root node
-	Comparator utils.Comparator[K] // Key comparator
-	size       int                 // Total number of keys in the tree
-	m          int                 // order (maximum number of children)
+	Root       *Node[K, T]               // Root node
+	Comparator utils.Comparator[K]       // Key comparator
+	size       int                       // Total number of keys in the tree
+	m          int                       // order (maximum number of children)
+	version    int                       // incremented on every modification, used by iterators to detect concurrent modification
+	nodes      *arena.Arena[Node[K, T]]  // allocates the nodes if set, otherwise they are allocated one by one
+	entries    *arena.Arena[Entry[K, T]] // allocates the entries if set, otherwise they are allocated one by one
 }
 
 // Node is a single element within the tree
@@ -57,6 +61,18 @@ func NewWith[K comparable, T any](order int, comparator utils.Comparator[K]) *Tr
 	return &Tree[K, T]{m: order, Comparator: comparator}
 }
 
+// NewWithArena instantiates a B-tree with the order (maximum number of children) and a custom key comparator,
+// whose nodes and entries are allocated chunkSize at a time and reused after removal. This reduces the number of
+// heap objects, and thus the garbage collection work, of large trees. A chunk is only reclaimed once all of its
+// nodes or entries are removed, and nodes obtained through GetNode, etc. must not be used after the tree was modified.
+// A chunk size of one only reuses removed nodes and entries.
+func NewWithArena[K comparable, T any](order int, comparator utils.Comparator[K], chunkSize int) *Tree[K, T] {
+	tree := NewWith[K, T](order, comparator)
+	tree.nodes = arena.New[Node[K, T]](chunkSize)
+	tree.entries = arena.New[Entry[K, T]](chunkSize)
+	return tree
+}
+
 // NewWithNumberComparator instantiates a B-tree with the order (maximum number of children) and the IntComparator, i.e. keys are of type int.
 func NewWithNumberComparator[T any](order int) *Tree[int, T] {
 	return NewWith[int, T](order, utils.NumberComparator[int])
@@ -71,16 +87,21 @@ func NewWithStringComparator[T any](order int) *Tree[string, T] {
 // If key already exists, then its value is updated with the new value.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, T]) Put(key K, value T) {
-	entry := &Entry[K, T]{Key: key, Value: value}
+	entry := tree.newEntry(key, value)
 
 	if tree.Root == nil {
-		tree.Root = &Node[K, T]{Entries: []*Entry[K, T]{entry}, Children: []*Node[K, T]{}}
+		tree.Root = tree.newNode(nil)
+		tree.Root.Entries = append(tree.Root.Entries, entry)
 		tree.size++
+		tree.version++
 		return
 	}
 
 	if tree.insert(tree.Root, entry) {
 		tree.size++
+		tree.version++
+	} else {
+		tree.freeEntry(entry) // the existing entry was updated
 	}
 }
 
@@ -110,6 +131,7 @@ func (tree *Tree[K, T]) Remove(key K) {
 	if found {
 		tree.delete(node, index)
 		tree.size--
+		tree.version++
 	}
 }
 
@@ -160,6 +182,11 @@ func (tree *Tree[K, T]) Values() []T {
 func (tree *Tree[K, T]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.version++
+	if tree.nodes != nil {
+		tree.nodes.Reset()
+		tree.entries.Reset()
+	}
 }
 
 // Height returns the height of the tree.
@@ -325,7 +352,7 @@ func (tree *Tree[K, T]) insert(node *Node[K, T], entry *Entry[K, T]) (inserted b
 func (tree *Tree[K, T]) insertIntoLeaf(node *Node[K, T], entry *Entry[K, T]) (inserted bool) {
 	insertPosition, found := tree.search(node, entry.Key)
 	if found {
-		node.Entries[insertPosition] = entry
+		*node.Entries[insertPosition] = *entry // update in place, so that iterators see the new value
 		return false
 	}
 	// Insert entry's key in the middle of the node
@@ -339,7 +366,7 @@ func (tree *Tree[K, T]) insertIntoLeaf(node *Node[K, T], entry *Entry[K, T]) (in
 func (tree *Tree[K, T]) insertIntoInternal(node *Node[K, T], entry *Entry[K, T]) (inserted bool) {
 	insertPosition, found := tree.search(node, entry.Key)
 	if found {
-		node.Entries[insertPosition] = entry
+		*node.Entries[insertPosition] = *entry // update in place, so that iterators see the new value
 		return false
 	}
 	return tree.insert(node.Children[insertPosition], entry)
@@ -362,13 +389,15 @@ func (tree *Tree[K, T]) splitNonRoot(node *Node[K, T]) {
 	middle := tree.middle()
 	parent := node.Parent
 
-	left := &Node[K, T]{Entries: append([]*Entry[K, T](nil), node.Entries[:middle]...), Parent: parent}
-	right := &Node[K, T]{Entries: append([]*Entry[K, T](nil), node.Entries[middle+1:]...), Parent: parent}
+	left := tree.newNode(parent)
+	left.Entries = append(left.Entries, node.Entries[:middle]...)
+	right := tree.newNode(parent)
+	right.Entries = append(right.Entries, node.Entries[middle+1:]...)
 
 	// Move children from the node to be split into left and right nodes
 	if !tree.isLeaf(node) {
-		left.Children = append([]*Node[K, T](nil), node.Children[:middle+1]...)
-		right.Children = append([]*Node[K, T](nil), node.Children[middle+1:]...)
+		left.Children = append(left.Children, node.Children[:middle+1]...)
+		right.Children = append(right.Children, node.Children[middle+1:]...)
 		setParent(left.Children, left)
 		setParent(right.Children, right)
 	}
@@ -388,31 +417,34 @@ func (tree *Tree[K, T]) splitNonRoot(node *Node[K, T]) {
 	copy(parent.Children[insertPosition+2:], parent.Children[insertPosition+1:])
 	parent.Children[insertPosition+1] = right
 
+	tree.freeNode(node)
 	tree.split(parent)
 }
 
 func (tree *Tree[K, T]) splitRoot() {
 	middle := tree.middle()
 
-	left := &Node[K, T]{Entries: append([]*Entry[K, T](nil), tree.Root.Entries[:middle]...)}
-	right := &Node[K, T]{Entries: append([]*Entry[K, T](nil), tree.Root.Entries[middle+1:]...)}
+	left := tree.newNode(nil)
+	left.Entries = append(left.Entries, tree.Root.Entries[:middle]...)
+	right := tree.newNode(nil)
+	right.Entries = append(right.Entries, tree.Root.Entries[middle+1:]...)
 
 	// Move children from the node to be split into left and right nodes
 	if !tree.isLeaf(tree.Root) {
-		left.Children = append([]*Node[K, T](nil), tree.Root.Children[:middle+1]...)
-		right.Children = append([]*Node[K, T](nil), tree.Root.Children[middle+1:]...)
+		left.Children = append(left.Children, tree.Root.Children[:middle+1]...)
+		right.Children = append(right.Children, tree.Root.Children[middle+1:]...)
 		setParent(left.Children, left)
 		setParent(right.Children, right)
 	}
 
 	// Root is a node with one entry and two children (left and right)
-	newRoot := &Node[K, T]{
-		Entries:  []*Entry[K, T]{tree.Root.Entries[middle]},
-		Children: []*Node[K, T]{left, right},
-	}
+	newRoot := tree.newNode(nil)
+	newRoot.Entries = append(newRoot.Entries, tree.Root.Entries[middle])
+	newRoot.Children = append(newRoot.Children, left, right)
 
 	left.Parent = newRoot
 	right.Parent = newRoot
+	tree.freeNode(tree.Root)
 	tree.Root = newRoot
 }
 
@@ -478,13 +510,16 @@ func (tree *Tree[K, T]) rightSibling(node *Node[K, T], key K) (*Node[K, T], int)
 // ref.: https://en.wikipedia.org/wiki/B-tree#Deletion
 func (tree *Tree[K, T]) delete(node *Node[K, T], index int) {
 	// deleting from a leaf node
+	deletedEntry := node.Entries[index]
 	if tree.isLeaf(node) {
-		deletedKey := node.Entries[index].Key
+		deletedKey := deletedEntry.Key
 		tree.deleteEntry(node, index)
 		tree.rebalance(node, deletedKey)
 		if len(tree.Root.Entries) == 0 {
+			tree.freeNode(tree.Root)
 			tree.Root = nil
 		}
+		tree.freeEntry(deletedEntry)
 		return
 	}
 
@@ -495,6 +530,7 @@ func (tree *Tree[K, T]) delete(node *Node[K, T], index int) {
 	deletedKey := leftLargestNode.Entries[leftLargestEntryIndex].Key
 	tree.deleteEntry(leftLargestNode, leftLargestEntryIndex)
 	tree.rebalance(leftLargestNode, deletedKey)
+	tree.freeEntry(deletedEntry)
 }
 
 // rebalance rebalances the tree after deletion if necessary and returns true, otherwise false.
@@ -546,6 +582,7 @@ func (tree *Tree[K, T]) rebalance(node *Node[K, T], deletedKey K) {
 		tree.deleteEntry(node.Parent, rightSiblingIndex-1)
 		tree.appendChildren(node.Parent.Children[rightSiblingIndex], node)
 		tree.deleteChild(node.Parent, rightSiblingIndex)
+		tree.freeNode(rightSibling)
 	} else if leftSibling != nil {
 		// merge with left sibling
 		entries := append([]*Entry[K, T](nil), leftSibling.Entries...)
@@ -555,10 +592,12 @@ func (tree *Tree[K, T]) rebalance(node *Node[K, T], deletedKey K) {
 		tree.deleteEntry(node.Parent, leftSiblingIndex)
 		tree.prependChildren(node.Parent.Children[leftSiblingIndex], node)
 		tree.deleteChild(node.Parent, leftSiblingIndex)
+		tree.freeNode(leftSibling)
 	}
 
 	// make the merged node the root if its parent was the root and the root is empty
 	if node.Parent == tree.Root && len(tree.Root.Entries) == 0 {
+		tree.freeNode(tree.Root)
 		tree.Root = node
 		node.Parent = nil
 		return
@@ -585,6 +624,44 @@ func (tree *Tree[K, T]) deleteEntry(node *Node[K, T], index int) {
 	node.Entries = node.Entries[:len(node.Entries)-1]
 }
 
+// newNode allocates a node without entries and children, from the arena if the tree has one
+func (tree *Tree[K, T]) newNode(parent *Node[K, T]) *Node[K, T] {
+	if tree.nodes == nil {
+		return &Node[K, T]{Parent: parent}
+	}
+	node := tree.nodes.Alloc()
+	node.Parent = parent
+	return node
+}
+
+// freeNode returns a node that is not part of the tree anymore to the arena if the tree has one,
+// keeping the capacity of its entries and children for the next node
+func (tree *Tree[K, T]) freeNode(node *Node[K, T]) {
+	if tree.nodes != nil {
+		clear(node.Entries[:cap(node.Entries)])
+		clear(node.Children[:cap(node.Children)])
+		node.Parent, node.Entries, node.Children = nil, node.Entries[:0], node.Children[:0]
+		tree.nodes.Reuse(node)
+	}
+}
+
+// newEntry allocates an entry, from the arena if the tree has one
+func (tree *Tree[K, T]) newEntry(key K, value T) *Entry[K, T] {
+	if tree.entries == nil {
+		return &Entry[K, T]{Key: key, Value: value}
+	}
+	entry := tree.entries.Alloc()
+	entry.Key, entry.Value = key, value
+	return entry
+}
+
+// freeEntry returns a removed entry to the arena if the tree has one
+func (tree *Tree[K, T]) freeEntry(entry *Entry[K, T]) {
+	if tree.entries != nil {
+		tree.entries.Free(entry)
+	}
+}
+
 func (tree *Tree[K, T]) deleteChild(node *Node[K, T], index int) {
 	if index >= len(node.Children) {
 		return
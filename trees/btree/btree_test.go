@@ -6,10 +6,15 @@ package btree
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/utils"
 )
 
@@ -1224,6 +1229,360 @@ func TestBTreeString(t *testing.T) {
 	}
 }
 
+func TestBTreeClone(t *testing.T) {
+	tree := NewWithNumberComparator[string](3)
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+	clone := tree.Clone()
+	if actualValue, expectedValue := clone.String(), tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := clone.Equal(tree); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for i := 1; i <= 10; i++ {
+		clone.Remove(i)
+	}
+	clone.Put(21, "21")
+	if actualValue, expectedValue := tree.Size(), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Size(), 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 1; i <= 20; i++ {
+		if actualValue, found := tree.Get(i); actualValue != fmt.Sprintf("%d", i) || !found {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d", clone.Keys()[0], clone.Keys()[10]), "1121"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := tree.DeepClone(func(value string) string { return value + "!" })
+	if actualValue, expectedValue := deepClone.Size(), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := deepClone.Get(7); actualValue != "7!" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "7!")
+	}
+	if actualValue := deepClone.Equal(tree); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	empty := NewWithNumberComparator[string](3).Clone()
+	if actualValue := empty.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestBTreeEqual(t *testing.T) {
+	tree := NewWithNumberComparator[string](3)
+	other := NewWithNumberComparator[string](3)
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, "x")
+		other.Put(11-i, "x")
+	}
+	if actualValue := tree.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(5, "y")
+	if actualValue := tree.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Remove(5)
+	if actualValue := tree.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestBTreeIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	tree := NewWithNumberComparator[string](3)
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+	it := tree.Iterator()
+	it.Next()
+	tree.Put(1, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put(4, "d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Key() })
+
+	it.End()
+	tree.Remove(1) // an iterator past the last element is not affected
+	it.Prev()
+	if actualValue, expectedValue := it.Key(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Remove(3)
+	assertConcurrentModification(func() { it.Prev() })
+
+	it.Begin()
+	tree.Remove(4) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestBTreeArena(t *testing.T) {
+	tree, expected := NewWithArena[int, int](4, utils.NumberComparator[int], 8), NewWithNumberComparator[int](4)
+	for i := 0; i < 1000; i++ {
+		key := (i * 7919) % 500 // revisits keys, so that some puts update existing ones
+		if i%3 == 2 {
+			tree.Remove(key)
+			expected.Remove(key)
+		} else {
+			tree.Put(key, i)
+			expected.Put(key, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), fmt.Sprint(expected.Keys(), expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Size(), expected.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone := tree.Clone()
+	tree.Clear()
+	if actualValue, expectedValue := fmt.Sprint(clone.Keys()), fmt.Sprint(expected.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removed nodes and entries are reused together with the capacity of their slices, so that refilling the tree
+	// hardly allocates
+	churn := func(tree *Tree[int, int]) float64 {
+		for i := 0; i < 100; i++ {
+			tree.Put(i, i)
+		}
+		return testing.AllocsPerRun(10, func() {
+			for i := 0; i < 100; i++ {
+				tree.Remove(i)
+			}
+			for i := 0; i < 100; i++ {
+				tree.Put(i, i)
+			}
+		})
+	}
+	if actualValue, expectedValue := churn(tree), churn(NewWithNumberComparator[int](4))/10; actualValue >= expectedValue {
+		t.Errorf("Got %v expected less than %v", actualValue, expectedValue)
+	}
+}
+
+func TestTreeIteratorConformance(t *testing.T) {
+	containertest.TestIteratorWithKey(t, func(keys []int, values []string) containers.IteratorWithKey[int, string] {
+		tree := NewWithNumberComparator[string](3)
+		for index, key := range keys {
+			tree.Put(key, values[index])
+		}
+		it := tree.Iterator()
+		return &it
+	})
+}
+
+func TestBTreeToDOT(t *testing.T) {
+	tree := NewWithNumberComparator[string](3)
+	if actualValue, expectedValue := tree.ToDOT(), "digraph \"BTree\" {\n\tnode [shape=box, ordering=out];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 1; i <= 4; i++ {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	expected := `digraph "BTree" {
+	node [shape=box, ordering=out];
+	n0 [label="2", xlabel="1/2"];
+	n1 [label="1", xlabel="1/2"];
+	n0 -> n1;
+	n2 [label="3 4", xlabel="2/2"];
+	n0 -> n2;
+}
+`
+	if actualValue, expectedValue := tree.ToDOT(), expected; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeValidate(t *testing.T) {
+	for _, order := range []int{3, 4, 5, 10} {
+		for _, tree := range []*Tree[int, int]{NewWithNumberComparator[int](order), NewWithArena[int, int](order, utils.NumberComparator[int], 16)} {
+			if err := tree.Validate(); err != nil {
+				t.Errorf("Got error %v", err)
+			}
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 2000; i++ {
+				if key := r.Intn(200); r.Intn(3) == 0 {
+					tree.Remove(key)
+				} else {
+					tree.Put(key, i)
+				}
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("Got error %v after %d operations with order %d", err, i+1, order)
+				}
+			}
+		}
+	}
+
+	// corruptions of a valid tree and whether they are detected
+	tests := []struct {
+		name    string
+		corrupt func(tree *Tree[int, int])
+	}{
+		{"overfull node", func(tree *Tree[int, int]) {
+			leaf := tree.Right()
+			leaf.Entries = append(leaf.Entries, &Entry[int, int]{Key: 100}, &Entry[int, int]{Key: 101})
+			tree.size += 2
+		}},
+		{"underfull node", func(tree *Tree[int, int]) {
+			leaf := tree.Left()
+			tree.size -= len(leaf.Entries)
+			leaf.Entries = nil
+		}},
+		{"key order within node", func(tree *Tree[int, int]) {
+			leaf := tree.Left()
+			leaf.Entries = append(leaf.Entries, &Entry[int, int]{Key: 0})
+			tree.size++
+		}},
+		{"key order across nodes", func(tree *Tree[int, int]) { tree.Left().Entries[0].Key = 100 }},
+		{"children count", func(tree *Tree[int, int]) { tree.Root.Children = tree.Root.Children[1:] }},
+		{"parent link", func(tree *Tree[int, int]) { tree.Root.Children[0].Parent = nil }},
+		{"leaf depth", func(tree *Tree[int, int]) {
+			child := tree.Root.Children[0]
+			child.Children = nil
+			for _, key := range []int{child.Entries[0].Key - 1, child.Entries[0].Key + 1} {
+				child.Children = append(child.Children, &Node[int, int]{Parent: child, Entries: []*Entry[int, int]{{Key: key}}})
+			}
+		}},
+		{"size", func(tree *Tree[int, int]) { tree.size++ }},
+	}
+	for _, test := range tests {
+		tree := NewWithNumberComparator[int](3)
+		for i := 1; i <= 20; i++ {
+			tree.Put(i*10, i)
+		}
+		test.corrupt(tree)
+		if err := tree.Validate(); !errors.Is(err, trees.ErrInvalid) {
+			t.Errorf("Got %v expected %v for %v", err, trees.ErrInvalid, test.name)
+		}
+	}
+}
+
+func TestBTreeFromSorted(t *testing.T) {
+	for order := 3; order <= 10; order++ {
+		for size := 0; size <= 600; size++ {
+			keys, values := make([]int, size), make([]int, size)
+			for i := range keys {
+				keys[i], values[i] = 2*i, i
+			}
+			tree := FromSorted(order, utils.NumberComparator[int], keys, values)
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("Got error %v for %d keys of order %d", err, size, order)
+			}
+			if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), fmt.Sprint(keys, values); actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			tree.Put(-1, -1) // the tree stays valid when modified afterwards
+			tree.Remove(size)
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("Got error %v for %d keys of order %d after modification", err, size, order)
+			}
+		}
+	}
+
+	// the tree is as low as possible, i.e. full nodes are only split when needed
+	keys := make([]int, 8)
+	for i := range keys {
+		keys[i] = i
+	}
+	if actualValue, expectedValue := FromSorted(3, utils.NumberComparator[int], keys, keys).Height(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := FromSorted(3, utils.NumberComparator[int], append(keys, 8), append(keys, 8)).Height(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	assertPanic := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Got %v expected a panic", r)
+			}
+		}()
+		f()
+	}
+	assertPanic(func() { FromSorted(3, utils.NumberComparator[int], []int{1, 2}, []string{"a"}) })
+	assertPanic(func() { FromSorted(3, utils.NumberComparator[int], []int{2, 1}, []string{"b", "a"}) })
+	assertPanic(func() { FromSorted(3, utils.NumberComparator[int], []int{1, 1}, []string{"a", "a"}) })
+	assertPanic(func() { FromSorted(2, utils.NumberComparator[int], []int{1}, []string{"a"}) })
+}
+
+func TestBTreeFromSortedIterator(t *testing.T) {
+	source := NewWithNumberComparator[string](3)
+	for i, value := range []string{"a", "b", "c", "d", "e"} {
+		source.Put(i+1, value)
+	}
+	it := source.Iterator()
+	tree := FromSortedIterator[int, string](4, utils.NumberComparator[int], &it)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[1 2 3 4 5] [a b c d e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// descending keys are rejected
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got %v expected a panic", r)
+		}
+	}()
+	descending := NewWith[int, string](3, utils.Reverse(utils.NumberComparator[int]))
+	descending.Put(1, "a")
+	descending.Put(2, "b")
+	it = descending.Iterator()
+	FromSortedIterator[int, string](3, utils.NumberComparator[int], &it)
+}
+
+func TestBTreeFromJSONBuildsBalancedTree(t *testing.T) {
+	for _, tree := range []*Tree[int, int]{NewWithNumberComparator[int](3), NewWithArena[int, int](3, utils.NumberComparator[int], 4)} {
+		tree.Put(1000, 0) // replaced by the JSON input
+		if err := tree.FromJSON([]byte(`{"5":5,"1":1,"3":3,"2":2,"4":4,"10":10}`)); err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[1 2 3 4 5 10] [1 2 3 4 5 10]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+// constructors of the trees under benchmark, allocating the nodes one by one and from an arena
+var benchmarkTrees = []struct {
+	name string
+	new  func() *Tree[int, struct{}]
+}{
+	{"New", func() *Tree[int, struct{}] { return NewWithNumberComparator[struct{}](128) }},
+	{"Arena", func() *Tree[int, struct{}] {
+		return NewWithArena[int, struct{}](128, utils.NumberComparator[int], 1024)
+	}},
+}
+
 func benchmarkGet(b *testing.B, tree *Tree[int, struct{}], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -1249,130 +1608,190 @@ func benchmarkRemove(b *testing.B, tree *Tree[int, struct{}], size int) {
 }
 
 func BenchmarkBTreeGet100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkBTreeGet1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkBTreeGet10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkBTreeGet100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkBTreePut100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}](128)
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
+	}
 }
 
 func BenchmarkBTreePut1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkBTreePut10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkBTreePut100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkBTreeRemove100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkBTreeRemove1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkBTreeRemove10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkBTreeRemove100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}](128)
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
-	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
+	}
 }
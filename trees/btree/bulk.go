@@ -0,0 +1,93 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// FromSorted instantiates a B-tree with the order (maximum number of children) and a custom key comparator
+// from keys that are already in ascending order without duplicates and their corresponding values, in O(n)
+// without any splits. Panics if the slices are of different lengths or the keys are not strictly ascending.
+func FromSorted[K comparable, T any](order int, comparator utils.Comparator[K], keys []K, values []T) *Tree[K, T] {
+	if len(keys) != len(values) {
+		panic("Invalid values, should be as many as keys")
+	}
+	if !ascending(comparator, keys) {
+		panic("Invalid keys, should be in ascending order without duplicates")
+	}
+	tree := NewWith[K, T](order, comparator)
+	tree.build(keys, values)
+	return tree
+}
+
+// FromSortedIterator instantiates a B-tree with the order (maximum number of children) and a custom key comparator
+// from the remaining elements of the iterator, whose keys have to be in ascending order without duplicates, in O(n).
+// Panics if the keys are not strictly ascending.
+func FromSortedIterator[K comparable, T any](order int, comparator utils.Comparator[K], it containers.IteratorWithKey[K, T]) *Tree[K, T] {
+	var keys []K
+	var values []T
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	return FromSorted(order, comparator, keys, values)
+}
+
+// build fills the empty tree with the sorted keys and values. The tree gets the least height able to hold them,
+// and the entries of every node are distributed evenly among its children, using as few children as possible.
+func (tree *Tree[K, T]) build(keys []K, values []T) {
+	// capacities[level] is the maximum number of entries of a subtree whose leaves are level levels below its root
+	capacities := []int{tree.maxEntries()}
+	for capacities[len(capacities)-1] < len(keys) {
+		capacities = append(capacities, tree.maxEntries()+tree.maxChildren()*capacities[len(capacities)-1])
+	}
+	if len(keys) > 0 {
+		tree.Root = tree.buildSubtree(keys, values, nil, capacities)
+	}
+	tree.size = len(keys)
+	tree.version++
+}
+
+func (tree *Tree[K, T]) buildSubtree(keys []K, values []T, parent *Node[K, T], capacities []int) *Node[K, T] {
+	node := tree.newNode(parent)
+	level := len(capacities) - 1
+	if level == 0 {
+		for i, key := range keys {
+			node.Entries = append(node.Entries, tree.newEntry(key, values[i]))
+		}
+		return node
+	}
+	childCapacity := capacities[level-1]
+	children := (len(keys) + childCapacity + 1) / (childCapacity + 1) // ceil((n+1)/(capacity+1))
+	if parent != nil {
+		children = max(children, tree.minChildren())
+	}
+	childEntries, extra := (len(keys)-children+1)/children, (len(keys)-children+1)%children
+	start := 0
+	for i := 0; i < children; i++ {
+		end := start + childEntries
+		if i < extra {
+			end++
+		}
+		node.Children = append(node.Children, tree.buildSubtree(keys[start:end], values[start:end], node, capacities[:level]))
+		if i < children-1 {
+			node.Entries = append(node.Entries, tree.newEntry(keys[end], values[end]))
+		}
+		start = end + 1
+	}
+	return node
+}
+
+// ascending returns true if the keys are in ascending order without duplicates
+func ascending[K any](comparator utils.Comparator[K], keys []K) bool {
+	for i := 1; i < len(keys); i++ {
+		if comparator(keys[i-1], keys[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
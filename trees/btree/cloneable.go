@@ -0,0 +1,68 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/internal/arena"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Tree[int, int], int] = (*Tree[int, int])(nil)
+
+// Clone returns a copy of the tree with the same order and the same node layout.
+// Runs in O(n) without any splitting or merging of nodes.
+func (tree *Tree[K, T]) Clone() *Tree[K, T] {
+	return tree.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the tree where every value is passed through the given copy function.
+// Keys are copied by assignment. Runs in O(n) without any splitting or merging of nodes.
+func (tree *Tree[K, T]) DeepClone(copyValue func(value T) T) *Tree[K, T] {
+	clone := &Tree[K, T]{
+		Comparator: tree.Comparator,
+		size:       tree.size,
+		m:          tree.m,
+	}
+	if tree.nodes != nil {
+		clone.nodes = arena.New[Node[K, T]](tree.nodes.ChunkSize())
+		clone.entries = arena.New[Entry[K, T]](tree.entries.ChunkSize())
+	}
+	clone.Root = clone.cloneNode(tree.Root, nil, copyValue)
+	return clone
+}
+
+// Equal returns true if both trees hold the same keys and values in the same order.
+// Keys and values are compared with utils.DefaultEquality.
+func (tree *Tree[K, T]) Equal(other *Tree[K, T]) bool {
+	if tree.size != other.size {
+		return false
+	}
+	keyEqual, valueEqual := utils.DefaultEquality[K](), utils.DefaultEquality[T]()
+	it1, it2 := tree.Iterator(), other.Iterator()
+	for it1.Next() && it2.Next() {
+		if !keyEqual(it1.Key(), it2.Key()) || !valueEqual(it1.Value(), it2.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tree *Tree[K, T]) cloneNode(node *Node[K, T], parent *Node[K, T], copyValue func(value T) T) *Node[K, T] {
+	if node == nil {
+		return nil
+	}
+	clone := tree.newNode(parent)
+	clone.Entries = make([]*Entry[K, T], len(node.Entries), cap(node.Entries))
+	clone.Children = make([]*Node[K, T], len(node.Children), cap(node.Children))
+	for i, entry := range node.Entries {
+		clone.Entries[i] = tree.newEntry(entry.Key, copyValue(entry.Value))
+	}
+	for i, child := range node.Children {
+		clone.Children[i] = tree.cloneNode(child, clone, copyValue)
+	}
+	return clone
+}
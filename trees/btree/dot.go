@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ugurcsen/gods-generic/internal/dot"
+)
+
+// ToDOT outputs the structure of the tree in the Graphviz DOT language, with the keys of every node
+// and its fill, i.e. the number of its entries out of the maximum number of entries, next to it.
+// Render it with e.g. "dot -Tsvg tree.dot > tree.svg".
+func (tree *Tree[K, T]) ToDOT() string {
+	graph := dot.New("BTree", "shape=box, ordering=out")
+	if !tree.Empty() {
+		tree.outputDOT(graph, tree.Root)
+	}
+	return graph.String()
+}
+
+func (tree *Tree[K, T]) outputDOT(graph *dot.Graph, node *Node[K, T]) int {
+	keys := make([]string, len(node.Entries))
+	for i, entry := range node.Entries {
+		keys[i] = entry.String()
+	}
+	fill := fmt.Sprintf("xlabel=%q", fmt.Sprintf("%d/%d", len(node.Entries), tree.maxEntries()))
+	id := graph.Node(strings.Join(keys, " "), fill)
+	for _, child := range node.Children {
+		graph.Edge(id, tree.outputDOT(graph, child))
+	}
+	return id
+}
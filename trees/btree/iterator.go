@@ -15,6 +15,7 @@ type Iterator[K comparable, T any] struct {
 	node     *Node[K, T]
 	entry    *Entry[K, T]
 	position position
+	version  int
 }
 
 type position byte
@@ -24,8 +25,9 @@ const (
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator panics with containers.ErrConcurrentModification if the tree is modified during iteration.
 func (tree *Tree[K, T]) Iterator() Iterator[K, T] {
-	return Iterator[K, T]{tree: tree, node: nil, position: begin}
+	return Iterator[K, T]{tree: tree, node: nil, position: begin, version: tree.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -33,6 +35,10 @@ func (tree *Tree[K, T]) Iterator() Iterator[K, T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Next() bool {
+	if iterator.position == begin {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	// If already at end, go to end
 	if iterator.position == end {
 		goto end
@@ -92,6 +98,10 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Prev() bool {
+	if iterator.position == end {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	// If already at beginning, go to begin
 	if iterator.position == begin {
 		goto begin
@@ -150,18 +160,21 @@ between:
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Value() T {
+	iterator.checkVersion()
 	return iterator.entry.Value
 }
 
 // Key returns the current element's key.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Key() K {
+	iterator.checkVersion()
 	return iterator.entry.Key
 }
 
 // Node returns the current element's node.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Node() *Node[K, T] {
+	iterator.checkVersion()
 	return iterator.node
 }
 
@@ -171,6 +184,7 @@ func (iterator *Iterator[K, T]) Begin() {
 	iterator.node = nil
 	iterator.position = begin
 	iterator.entry = nil
+	iterator.version = iterator.tree.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -179,6 +193,7 @@ func (iterator *Iterator[K, T]) End() {
 	iterator.node = nil
 	iterator.position = end
 	iterator.entry = nil
+	iterator.version = iterator.tree.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -224,3 +239,10 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// checkVersion panics if the tree was modified since the iterator was created or reset.
+func (iterator *Iterator[K, T]) checkVersion() {
+	if iterator.version != iterator.tree.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -25,14 +25,29 @@ func (tree *Tree[K, T]) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates the tree from the input JSON representation.
+// The keys are sorted with the comparator and the tree is built from them in one pass, unless the comparator
+// considers some of them equal, in which case they are put one by one.
 func (tree *Tree[K, T]) FromJSON(data []byte) error {
 	elements := make(map[K]T)
 	err := json.Unmarshal(data, &elements)
 	if err == nil {
 		tree.Clear()
-		for key, value := range elements {
-			tree.Put(key, value)
+		keys := make([]K, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
 		}
+		utils.Sort(keys, tree.Comparator)
+		if !ascending(tree.Comparator, keys) {
+			for _, key := range keys {
+				tree.Put(key, elements[key])
+			}
+			return nil
+		}
+		values := make([]T, len(keys))
+		for i, key := range keys {
+			values[i] = elements[key]
+		}
+		tree.build(keys, values)
 	}
 	return err
 }
@@ -0,0 +1,87 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package btree
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/trees"
+)
+
+// Validate checks the invariants of the B-tree and returns an error wrapping trees.ErrInvalid
+// describing the first violation found, or nil if the tree is valid:
+// keys are in ascending order, parent links are consistent, every node holds at most order-1 entries and,
+// except for the root, at least ⌈order/2⌉-1 entries, every internal node has one child more than entries,
+// all leaves are at the same depth, and the size matches the entry count.
+// Meant for debugging and testing, it visits every node.
+func (tree *Tree[K, T]) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("%w: empty tree has size %d", trees.ErrInvalid, tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("%w: root has parent", trees.ErrInvalid)
+	}
+	count, leafDepth := 0, -1
+	if err := tree.validate(tree.Root, nil, nil, 0, &leafDepth, &count); err != nil {
+		return err
+	}
+	if count != tree.size {
+		return fmt.Errorf("%w: tree has %d entries but size %d", trees.ErrInvalid, count, tree.size)
+	}
+	return nil
+}
+
+// validate checks the subtree at the given depth whose keys have to be between the keys of the lower and upper
+// entries, if any. The depth of the first leaf found is stored in leafDepth, the other leaves have to be at that depth.
+func (tree *Tree[K, T]) validate(node *Node[K, T], lower, upper *Entry[K, T], depth int, leafDepth, count *int) error {
+	*count += len(node.Entries)
+	if len(node.Entries) > tree.maxEntries() {
+		return fmt.Errorf("%w: node %v has %d entries, more than %d", trees.ErrInvalid, node.Entries, len(node.Entries), tree.maxEntries())
+	}
+	if node != tree.Root && len(node.Entries) < tree.minEntries() {
+		return fmt.Errorf("%w: node %v has %d entries, less than %d", trees.ErrInvalid, node.Entries, len(node.Entries), tree.minEntries())
+	}
+	previous := lower
+	for _, entry := range node.Entries {
+		if previous != nil && tree.Comparator(previous.Key, entry.Key) >= 0 {
+			return fmt.Errorf("%w: entry %v is not after %v", trees.ErrInvalid, entry, previous)
+		}
+		previous = entry
+	}
+	if n := len(node.Entries); n > 0 && upper != nil && tree.Comparator(node.Entries[n-1].Key, upper.Key) >= 0 {
+		return fmt.Errorf("%w: entry %v is not before %v", trees.ErrInvalid, node.Entries[n-1], upper)
+	}
+
+	if tree.isLeaf(node) {
+		if *leafDepth < 0 {
+			*leafDepth = depth
+		} else if depth != *leafDepth {
+			return fmt.Errorf("%w: leaf %v is at depth %d, other leaves at depth %d", trees.ErrInvalid, node.Entries, depth, *leafDepth)
+		}
+		return nil
+	}
+	if len(node.Children) != len(node.Entries)+1 {
+		return fmt.Errorf("%w: node %v has %d entries but %d children", trees.ErrInvalid, node.Entries, len(node.Entries), len(node.Children))
+	}
+	for i, child := range node.Children {
+		if child.Parent != node {
+			return fmt.Errorf("%w: child %v of node %v has another parent", trees.ErrInvalid, child.Entries, node.Entries)
+		}
+		childLower, childUpper := lower, upper
+		if i > 0 {
+			childLower = node.Entries[i-1]
+		}
+		if i < len(node.Entries) {
+			childUpper = node.Entries[i]
+		}
+		if err := tree.validate(child, childLower, childUpper, depth+1, leafDepth, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
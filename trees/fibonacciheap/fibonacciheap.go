@@ -0,0 +1,326 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package fibonacciheap implements a Fibonacci heap.
+//
+// A Fibonacci heap is a collection of heap-ordered trees with O(1) insert, meld and peek, O(1) amortized decrease-key
+// and O(log n) amortized pop and delete. Inserting returns a node, which can be used as a handle to decrease or delete
+// the value later on.
+//
+// Insert is the handle-returning variant of Push: Push keeps the signature of the trees.Heap interface, so that the heap
+// can be used wherever a Heap is expected, and does not return the nodes of the pushed values.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Fibonacci_heap
+package fibonacciheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ugurcsen/gods-generic/trees"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+
+// Heap holds the circular list of roots, starting with the top root
+type Heap[T any] struct {
+	top        *Node[T]
+	size       int
+	Comparator utils.Comparator[T]
+}
+
+// Node is a single element within the heap, returned by Insert as a handle to the value
+type Node[T any] struct {
+	value  T
+	parent *Node[T]
+	child  *Node[T] // any of the children
+	left   *Node[T] // previous sibling in the circular list
+	right  *Node[T] // next sibling in the circular list
+	degree int      // number of children
+	marked bool     // lost a child since it became a child of its parent
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// NewWithNumberComparator instantiates a new empty heap with the NumberComparator, i.e. elements are numbers.
+func NewWithNumberComparator[T utils.ComparableNumber]() *Heap[T] {
+	return &Heap[T]{Comparator: utils.NumberComparator[T]}
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Heap[string] {
+	return &Heap[string]{Comparator: utils.StringComparator}
+}
+
+// Value returns the value held by the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Insert adds a value onto the heap and returns its node, which can be used with DecreaseKey and Delete.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value}
+	node.left, node.right = node, node
+	heap.addRoot(node)
+	heap.size++
+	return node
+}
+
+// Push adds values onto the heap.
+// Use Insert instead to get the nodes needed by DecreaseKey and Delete.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	top := heap.top
+	if top == nil {
+		return value, false
+	}
+	// move the children to the list of roots
+	for top.child != nil {
+		child := top.child
+		top.child = remove(child)
+		child.parent = nil
+		child.marked = false
+		splice(top, child)
+	}
+	top.degree = 0
+	if top.right == top {
+		heap.top = nil
+	} else {
+		heap.top = remove(top)
+		heap.consolidate()
+	}
+	heap.size--
+	return top.value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.top == nil {
+		return value, false
+	}
+	return heap.top.value, true
+}
+
+// Meld moves all elements of the other heap into this heap in O(1), leaving the other heap empty.
+// Nodes of the other heap remain valid handles within this heap.
+// Both heaps are expected to use the same comparator.
+func (heap *Heap[T]) Meld(other *Heap[T]) {
+	if heap == other || other.top == nil {
+		return
+	}
+	heap.addRoot(other.top)
+	heap.size += other.size
+	other.top = nil
+	other.size = 0
+}
+
+// DecreaseKey changes the value of the node to the given value, which must not be ordered after the current value,
+// i.e. it moves the node towards the top of the heap.
+// Returns false and does not change anything if the given value is ordered after the current value.
+// The node must be held by the heap.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if parent := node.parent; parent != nil && heap.Comparator(value, parent.value) < 0 {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	if heap.Comparator(value, heap.top.value) < 0 {
+		heap.top = node
+	}
+	return true
+}
+
+// Delete removes the node from the heap.
+// The node must be held by the heap.
+func (heap *Heap[T]) Delete(node *Node[T]) {
+	if parent := node.parent; parent != nil {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	heap.top = node
+	heap.Pop()
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.top = nil
+	heap.size = 0
+}
+
+// Values returns all elements in the heap, ordered from the top of the heap.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	heap.each(func(node *Node[T]) {
+		values = append(values, node.value)
+	})
+	utils.Sort(values, heap.Comparator)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "FibonacciHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Calls the given function for all nodes of the heap in no particular order.
+func (heap *Heap[T]) each(f func(node *Node[T])) {
+	if heap.top == nil {
+		return
+	}
+	for stack := []*Node[T]{heap.top}; len(stack) > 0; {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for node := first; ; {
+			f(node)
+			if node.child != nil {
+				stack = append(stack, node.child)
+			}
+			if node = node.right; node == first {
+				break
+			}
+		}
+	}
+}
+
+// Adds the circular list of nodes to the list of roots and updates the top.
+func (heap *Heap[T]) addRoot(node *Node[T]) {
+	if heap.top == nil {
+		heap.top = node
+		return
+	}
+	splice(heap.top, node)
+	if heap.Comparator(node.value, heap.top.value) < 0 {
+		heap.top = node
+	}
+}
+
+// Links roots of equal degree until all roots have distinct degrees and finds the new top.
+// The top must point to any of the roots.
+func (heap *Heap[T]) consolidate() {
+	var degrees []*Node[T]
+	roots := []*Node[T]{}
+	for node := heap.top; ; {
+		roots = append(roots, node)
+		if node = node.right; node == heap.top {
+			break
+		}
+	}
+	for _, node := range roots {
+		for {
+			for node.degree >= len(degrees) {
+				degrees = append(degrees, nil)
+			}
+			other := degrees[node.degree]
+			if other == nil {
+				degrees[node.degree] = node
+				break
+			}
+			degrees[node.degree] = nil
+			if heap.Comparator(other.value, node.value) < 0 {
+				node, other = other, node
+			}
+			// make other a child of node
+			remove(other)
+			other.parent = node
+			other.marked = false
+			if node.child == nil {
+				node.child = other
+			} else {
+				splice(node.child, other)
+			}
+			node.degree++
+		}
+	}
+	heap.top = nil
+	for _, node := range degrees {
+		if node != nil && (heap.top == nil || heap.Comparator(node.value, heap.top.value) < 0) {
+			heap.top = node
+		}
+	}
+}
+
+// Moves the node from the children of its parent to the list of roots.
+func (heap *Heap[T]) cut(node *Node[T]) {
+	parent := node.parent
+	if parent.child == node {
+		if node.right == node {
+			parent.child = nil
+		} else {
+			parent.child = node.right
+		}
+	}
+	remove(node)
+	parent.degree--
+	node.parent = nil
+	node.marked = false
+	splice(heap.top, node)
+}
+
+// Cuts the node if it already lost a child, otherwise marks it, and continues with the parents.
+func (heap *Heap[T]) cascadingCut(node *Node[T]) {
+	for parent := node.parent; parent != nil; node, parent = parent, parent.parent {
+		if !node.marked {
+			node.marked = true
+			return
+		}
+		heap.cut(node)
+	}
+}
+
+// Inserts the circular list starting with other after the node within its circular list.
+func splice[T any](node *Node[T], other *Node[T]) {
+	last := other.left
+	last.right = node.right
+	node.right.left = last
+	node.right = other
+	other.left = node
+}
+
+// Removes the node from its circular list and returns any remaining node of the list (nil if none remains).
+func remove[T any](node *Node[T]) *Node[T] {
+	if node.right == node {
+		return nil
+	}
+	right := node.right
+	node.left.right = right
+	right.left = node.left
+	node.left, node.right = node, node
+	return right
+}
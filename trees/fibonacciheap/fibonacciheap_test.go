@@ -0,0 +1,327 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fibonacciheap
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+func TestFibonacciHeapPush(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+
+	if actualValue := heap.Values(); actualValue[0] != 1 || actualValue[1] != 2 || actualValue[2] != 3 {
+		t.Errorf("Got %v expected %v", actualValue, "[1,2,3]")
+	}
+	if actualValue := heap.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestFibonacciHeapPop(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+
+	heap.Push(3, 2, 1)
+	heap.Pop()
+
+	if actualValue, ok := heap.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestFibonacciHeapDecreaseKey(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(5, 3)
+	node := heap.Insert(10)
+	heap.Push(7, 8)
+	heap.Pop() // restructure
+
+	if actualValue := heap.DecreaseKey(node, 11); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(node, 1); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := node.Value(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestFibonacciHeapDelete(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(5, 3)
+	node := heap.Insert(4)
+	heap.Push(7, 1)
+	heap.Pop() // restructure
+
+	heap.Delete(node)
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := heap.Values(); actualValue[0] != 3 || actualValue[1] != 5 || actualValue[2] != 7 {
+		t.Errorf("Got %v expected %v", actualValue, "[3,5,7]")
+	}
+	top := heap.Insert(0)
+	heap.Delete(top)
+	if actualValue, ok := heap.Pop(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestFibonacciHeapMeld(t *testing.T) {
+	heap1 := NewWithNumberComparator[int]()
+	heap1.Push(5, 1, 9)
+	heap2 := NewWithNumberComparator[int]()
+	heap2.Push(6, 2)
+	node := heap2.Insert(8)
+
+	heap1.Meld(heap2)
+	heap1.Meld(heap1)
+	if actualValue := heap2.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap1.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	heap1.DecreaseKey(node, 0)
+	for _, expectedValue := range []int{0, 1, 2, 5, 6, 9} {
+		if actualValue, _ := heap1.Pop(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestFibonacciHeapRandom(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	nodes := map[int]*Node[int]{} // values are kept unique to know which node was popped
+	unused := func() int {
+		for {
+			if value := rand.Intn(100000); nodes[value] == nil {
+				return value
+			}
+		}
+	}
+	for i := 0; i < 20000; i++ {
+		switch r := rand.Intn(10); {
+		case r < 4 || len(nodes) == 0:
+			value := unused()
+			nodes[value] = heap.Insert(value)
+		case r < 6:
+			expectedValue := -1
+			for value := range nodes {
+				if expectedValue < 0 || value < expectedValue {
+					expectedValue = value
+				}
+			}
+			if actualValue, _ := heap.Pop(); actualValue != expectedValue {
+				t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+			}
+			delete(nodes, expectedValue)
+		case r < 8:
+			for value, node := range nodes {
+				if newValue := unused(); newValue < value {
+					heap.DecreaseKey(node, newValue)
+					delete(nodes, value)
+					nodes[newValue] = node
+				}
+				break
+			}
+		default:
+			for value, node := range nodes {
+				heap.Delete(node)
+				delete(nodes, value)
+				break
+			}
+		}
+		if heap.Size() != len(nodes) {
+			t.Fatalf("Got %v expected %v", heap.Size(), len(nodes))
+		}
+	}
+	values := heap.Values()
+	for i := 0; !heap.Empty(); i++ {
+		if actualValue, _ := heap.Pop(); actualValue != values[i] {
+			t.Fatalf("Got %v expected %v", actualValue, values[i])
+		}
+	}
+}
+
+func TestFibonacciHeapIterator(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3, 1, 2)
+
+	it := heap.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	for it.End(); it.Prev(); count-- {
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFibonacciHeapSerialization(t *testing.T) {
+	heap := NewWithStringComparator()
+
+	heap.Push("c", "b", "a")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := heap.Values(), []string{"a", "b", "c"}; actualValue[0] != expectedValue[0] || actualValue[1] != expectedValue[1] || actualValue[2] != expectedValue[2] {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := heap.ToJSON()
+	assert()
+
+	if actualValue, expectedValue := string(bytes), `["a","b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	err = heap.FromJSON([]byte(`["b","c","a"]`))
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["c","a","b"]`), &heap)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestFibonacciHeapString(t *testing.T) {
+	c := NewWithNumberComparator[int]()
+	c.Push(2, 1)
+	if actualValue, expectedValue := c.String(), "FibonacciHeap\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFibonacciHeapClone(t *testing.T) {
+	heap := NewWith(utils.Reverse(utils.NumberComparator[int]))
+	heap.Push(3, 1, 2, 5, 4)
+	heap.Pop()
+	clone := heap.Clone()
+	clone.Pop()
+	if actualValue, _ := heap.Peek(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	for _, expectedValue := range []int{3, 2, 1} {
+		if actualValue, _ := clone.Pop(); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := heap.DeepClone(func(value int) int { return value * 10 }).Values(); actualValue[0] != 40 || actualValue[3] != 10 {
+		t.Errorf("Got %v expected %v", actualValue, "[40,30,20,10]")
+	}
+}
+
+func TestFibonacciHeapEqual(t *testing.T) {
+	heap1 := NewWithNumberComparator[int]()
+	heap1.Push(3, 1, 2)
+	heap2 := NewWithNumberComparator[int]()
+	heap2.Push(1, 2, 3)
+	if actualValue := heap1.Equal(heap2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap2.Pop()
+	heap2.Push(4)
+	if actualValue := heap1.Equal(heap2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkFibonacciHeapPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
+
+func BenchmarkFibonacciHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := NewWithNumberComparator[int]()
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
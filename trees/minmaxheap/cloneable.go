@@ -0,0 +1,36 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxheap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Heap[int], int] = (*Heap[int])(nil)
+
+// Clone returns a copy of the heap with the same layout of elements.
+// Runs in O(n) without any sifting.
+func (heap *Heap[T]) Clone() *Heap[T] {
+	return &Heap[T]{list: heap.list.Clone(), Comparator: heap.Comparator}
+}
+
+// DeepClone returns a copy of the heap where every element is passed through the given copy function.
+// The copy function must not change the ordering of the elements with respect to the heap's comparator.
+func (heap *Heap[T]) DeepClone(copyValue func(value T) T) *Heap[T] {
+	return &Heap[T]{list: heap.list.DeepClone(copyValue), Comparator: heap.Comparator}
+}
+
+// Equal returns true if both heaps hold equal elements, i.e. they would pop the same elements in the same order,
+// apart from the order of elements the comparator considers equal.
+// Complexity is O(n log n), since the elements are sorted with the heap's comparator before comparing.
+// Elements are compared with utils.DefaultEquality.
+func (heap *Heap[T]) Equal(other *Heap[T]) bool {
+	if heap.Size() != other.Size() {
+		return false
+	}
+	return utils.SameElements(heap.list.Values(), other.list.Values(), heap.Comparator, utils.DefaultEquality[T]())
+}
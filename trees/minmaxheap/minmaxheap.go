@@ -0,0 +1,223 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package minmaxheap implements a min-max heap (double-ended priority queue) backed by array list.
+//
+// Elements on even levels (starting with the root) are smaller than all their descendants and elements on odd levels
+// are greater than all their descendants, so both the minimum and the maximum can be peeked in O(1) and popped in O(log n).
+//
+// Comparator defines the order of the elements.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Min-max_heap
+package minmaxheap
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/ugurcsen/gods-generic/lists/arraylist"
+	"github.com/ugurcsen/gods-generic/trees"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[int] = (*Heap[int])(nil)
+
+// Heap holds elements in an array-list
+type Heap[T any] struct {
+	list       *arraylist.List[T]
+	Comparator utils.Comparator[T]
+}
+
+// NewWith instantiates a new empty heap tree with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{list: arraylist.New[T](), Comparator: comparator}
+}
+
+// NewWithNumberComparator instantiates a new empty heap with the NumberComparator, i.e. elements are numbers.
+func NewWithNumberComparator[T utils.ComparableNumber]() *Heap[T] {
+	return &Heap[T]{list: arraylist.New[T](), Comparator: utils.NumberComparator[T]}
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Heap[string] {
+	return &Heap[string]{list: arraylist.New[string](), Comparator: utils.StringComparator}
+}
+
+// Push adds values onto the heap and bubbles them up accordingly.
+func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 1 {
+		heap.list.Add(values[0])
+		heap.bubbleUp(heap.list.Size() - 1)
+	} else {
+		heap.list.Add(values...)
+		heap.heapify()
+	}
+}
+
+// PeekMin returns the smallest element of the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMin() (value T, ok bool) {
+	return heap.list.Get(0)
+}
+
+// PeekMax returns the greatest element of the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMax() (value T, ok bool) {
+	return heap.list.Get(heap.maxIndex())
+}
+
+// PopMin removes the smallest element of the heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMin() (value T, ok bool) {
+	return heap.pop(0)
+}
+
+// PopMax removes the greatest element of the heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMax() (value T, ok bool) {
+	return heap.pop(heap.maxIndex())
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.list.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.list.Size()
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.list.Clear()
+}
+
+// Values returns all elements in the heap in ascending order.
+func (heap *Heap[T]) Values() []T {
+	values := heap.list.Values()
+	utils.Sort(values, heap.Comparator)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "MinMaxHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Removes the element at the index by replacing it with the last element and trickling that one down.
+func (heap *Heap[T]) pop(index int) (value T, ok bool) {
+	value, ok = heap.list.Get(index)
+	if !ok {
+		return
+	}
+	lastIndex := heap.list.Size() - 1
+	heap.list.Swap(index, lastIndex)
+	heap.list.Remove(lastIndex)
+	if index < lastIndex {
+		heap.trickleDown(index)
+	}
+	return
+}
+
+// Returns the index of the greatest element, i.e. the root if it is the only element, otherwise its greater child.
+func (heap *Heap[T]) maxIndex() int {
+	switch size := heap.list.Size(); {
+	case size <= 1:
+		return 0
+	case size == 2 || heap.compare(1, 2) >= 0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Restores the heap property of the whole list bottom-up.
+// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+func (heap *Heap[T]) heapify() {
+	for index := heap.list.Size()/2 - 1; index >= 0; index-- {
+		heap.trickleDown(index)
+	}
+}
+
+// Performs the "trickle down" operation. This is to place the element that is at the index
+// in its correct place among its descendants so that the heap maintains the min-max order property.
+func (heap *Heap[T]) trickleDown(index int) {
+	order := levelOrder(index)
+	for {
+		descendant := heap.extremeDescendant(index, order)
+		if descendant < 0 || heap.compare(descendant, index)*order >= 0 {
+			return
+		}
+		heap.list.Swap(descendant, index)
+		if descendant <= index<<1+2 {
+			return // child, i.e. on the opposite level, which has no descendants left to check
+		}
+		if parent := (descendant - 1) >> 1; heap.compare(descendant, parent)*order > 0 {
+			heap.list.Swap(descendant, parent)
+		}
+		index = descendant
+	}
+}
+
+// Performs the "bubble up" operation. This is to place a newly inserted element
+// in its correct place among its ancestors so that the heap maintains the min-max order property.
+func (heap *Heap[T]) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+	order := levelOrder(index)
+	if parent := (index - 1) >> 1; heap.compare(index, parent)*order > 0 {
+		heap.list.Swap(index, parent)
+		index, order = parent, -order
+	}
+	for index > 2 {
+		grandparent := ((index-1)>>1 - 1) >> 1
+		if heap.compare(index, grandparent)*order >= 0 {
+			return
+		}
+		heap.list.Swap(index, grandparent)
+		index = grandparent
+	}
+}
+
+// Returns the index of the smallest (order 1) or greatest (order -1) element among children and grandchildren
+// of the index, or -1 if the index has no children.
+func (heap *Heap[T]) extremeDescendant(index int, order int) int {
+	size := heap.list.Size()
+	extreme := -1
+	for _, descendant := range [...]int{index<<1 + 1, index<<1 + 2, index<<2 + 3, index<<2 + 4, index<<2 + 5, index<<2 + 6} {
+		if descendant >= size {
+			continue
+		}
+		if extreme < 0 || heap.compare(descendant, extreme)*order < 0 {
+			extreme = descendant
+		}
+	}
+	return extreme
+}
+
+func (heap *Heap[T]) compare(index1, index2 int) int {
+	value1, _ := heap.list.Get(index1)
+	value2, _ := heap.list.Get(index2)
+	return heap.Comparator(value1, value2)
+}
+
+// Returns 1 for indices on min levels (even depth) and -1 for indices on max levels (odd depth)
+func levelOrder(index int) int {
+	if bits.Len(uint(index+1))%2 == 1 {
+		return 1
+	}
+	return -1
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxheap
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+func TestMinMaxHeapPush(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+
+	if actualValue := heap.Values(); actualValue[0] != 1 || actualValue[1] != 2 || actualValue[2] != 3 {
+		t.Errorf("Got %v expected %v", actualValue, "[1,2,3]")
+	}
+	if actualValue := heap.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestMinMaxHeapPushBulk(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+
+	heap.Push(15, 20, 3, 1, 2)
+
+	if actualValue := heap.Values(); actualValue[0] != 1 || actualValue[1] != 2 || actualValue[2] != 3 {
+		t.Errorf("Got %v expected %v", actualValue, "[1,2,3]")
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 20 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+}
+
+func TestMinMaxHeapPop(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+
+	if actualValue, ok := heap.PeekMin(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	heap.Push(3, 2, 1, 4)
+
+	if actualValue, ok := heap.PopMax(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	var expected []int
+
+	for i := 0; i < 10000; i++ {
+		switch r := rand.Intn(4); {
+		case r < 2 || len(expected) == 0:
+			value := rand.Intn(1000)
+			heap.Push(value)
+			expected = append(expected, value)
+			utils.Sort(expected, utils.NumberComparator[int])
+		case r == 2:
+			actualValue, _ := heap.PopMin()
+			if actualValue != expected[0] {
+				t.Fatalf("Got %v expected %v", actualValue, expected[0])
+			}
+			expected = expected[1:]
+		default:
+			actualValue, _ := heap.PopMax()
+			if actualValue != expected[len(expected)-1] {
+				t.Fatalf("Got %v expected %v", actualValue, expected[len(expected)-1])
+			}
+			expected = expected[:len(expected)-1]
+		}
+		if heap.Size() != len(expected) {
+			t.Fatalf("Got %v expected %v", heap.Size(), len(expected))
+		}
+	}
+}
+
+func TestMinMaxHeapRandomBulk(t *testing.T) {
+	for size := 0; size < 100; size++ {
+		heap := NewWithNumberComparator[int]()
+		values := make([]int, size)
+		for i := range values {
+			values[i] = rand.Intn(50)
+		}
+		heap.Push(values...)
+		utils.Sort(values, utils.NumberComparator[int])
+		for low, high := 0, size-1; low <= high; low, high = low+1, high-1 {
+			if actualValue, _ := heap.PopMin(); actualValue != values[low] {
+				t.Fatalf("Got %v expected %v", actualValue, values[low])
+			}
+			if low == high {
+				break
+			}
+			if actualValue, _ := heap.PopMax(); actualValue != values[high] {
+				t.Fatalf("Got %v expected %v", actualValue, values[high])
+			}
+		}
+		if actualValue := heap.Empty(); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+	}
+}
+
+func TestMinMaxHeapIteratorOnEmpty(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	it := heap.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty heap")
+	}
+}
+
+func TestMinMaxHeapIterator(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3, 1, 2)
+
+	it := heap.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Index(), count-1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for it.End(); it.Prev(); count-- {
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if it.First(); it.Value() != 1 {
+		t.Errorf("Got %v expected %v", it.Value(), 1)
+	}
+	if it.Last(); it.Value() != 3 {
+		t.Errorf("Got %v expected %v", it.Value(), 3)
+	}
+	it.Begin()
+	if !it.NextTo(func(index int, value int) bool { return value > 1 }) || it.Value() != 2 {
+		t.Errorf("Got %v expected %v", it.Value(), 2)
+	}
+	it.End()
+	if !it.PrevTo(func(index int, value int) bool { return value < 3 }) || it.Value() != 2 {
+		t.Errorf("Got %v expected %v", it.Value(), 2)
+	}
+}
+
+func TestMinMaxHeapSerialization(t *testing.T) {
+	heap := NewWithStringComparator()
+
+	heap.Push("c", "b", "a")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := heap.Values(), []string{"a", "b", "c"}; actualValue[0] != expectedValue[0] || actualValue[1] != expectedValue[1] || actualValue[2] != expectedValue[2] {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := heap.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, _ := heap.PeekMax(); actualValue != "c" {
+			t.Errorf("Got %v expected %v", actualValue, "c")
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	bytes, err := heap.ToJSON()
+	assert()
+
+	if actualValue, expectedValue := string(bytes), `["a","b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	err = heap.FromJSON([]byte(`["b","c","a"]`))
+	assert()
+
+	bytes, err = json.Marshal([]interface{}{"a", "b", "c", heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	err = json.Unmarshal([]byte(`["c","a","b"]`), &heap)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assert()
+}
+
+func TestMinMaxHeapString(t *testing.T) {
+	c := NewWithNumberComparator[int]()
+	c.Push(2, 1)
+	if actualValue, expectedValue := c.String(), "MinMaxHeap\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !strings.HasPrefix(c.String(), "MinMaxHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestMinMaxHeapClone(t *testing.T) {
+	heap := NewWithNumberComparator[int]()
+	heap.Push(3, 1, 2)
+	clone := heap.Clone()
+	clone.PopMax()
+	if actualValue, _ := heap.PeekMax(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, _ := clone.PeekMax(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := heap.DeepClone(func(value int) int { return value * 10 }).Values(); actualValue[0] != 10 || actualValue[2] != 30 {
+		t.Errorf("Got %v expected %v", actualValue, "[10,20,30]")
+	}
+}
+
+func TestMinMaxHeapEqual(t *testing.T) {
+	heap1 := NewWithNumberComparator[int]()
+	heap1.Push(3, 1, 2)
+	heap2 := NewWithNumberComparator[int]()
+	heap2.Push(1)
+	heap2.Push(2)
+	heap2.Push(3)
+	if actualValue := heap1.Equal(heap2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap2.PopMin()
+	heap2.Push(4)
+	if actualValue := heap1.Equal(heap2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPopMinMax(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size/2; n++ {
+			heap.PopMin()
+			heap.PopMax()
+		}
+	}
+}
+
+func BenchmarkMinMaxHeapPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := NewWithNumberComparator[int]()
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPopMinMax(b, heap, size)
+}
+
+func BenchmarkMinMaxHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := NewWithNumberComparator[int]()
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package minmaxheap
+
+import (
+	"encoding/json"
+
+	"github.com/ugurcsen/gods-generic/containers"
+)
+
+// Assert Serialization implementation
+var _ containers.JSONSerializer = (*Heap[int])(nil)
+var _ containers.JSONDeserializer = (*Heap[int])(nil)
+
+// ToJSON outputs the JSON representation of the heap, i.e. its elements in ascending order.
+func (heap *Heap[T]) ToJSON() ([]byte, error) {
+	return json.Marshal(heap.Values())
+}
+
+// FromJSON populates the heap from the input JSON representation.
+// Elements do not need to be in any particular order.
+func (heap *Heap[T]) FromJSON(data []byte) error {
+	err := heap.list.FromJSON(data)
+	if err == nil {
+		heap.heapify()
+	}
+	return err
+}
+
+// UnmarshalJSON @implements json.Unmarshaler
+func (heap *Heap[T]) UnmarshalJSON(bytes []byte) error {
+	return heap.FromJSON(bytes)
+}
+
+// MarshalJSON @implements json.Marshaler
+func (heap *Heap[T]) MarshalJSON() ([]byte, error) {
+	return heap.ToJSON()
+}
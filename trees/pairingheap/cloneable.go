@@ -0,0 +1,52 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pairingheap
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Heap[int], int] = (*Heap[int])(nil)
+
+// Clone returns a copy of the heap with the same tree structure.
+// Nodes of the heap are not valid handles within the copy.
+func (heap *Heap[T]) Clone() *Heap[T] {
+	return heap.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the heap where every element is passed through the given copy function.
+// The copy function must not change the ordering of the elements with respect to the heap's comparator.
+func (heap *Heap[T]) DeepClone(copyValue func(value T) T) *Heap[T] {
+	return &Heap[T]{root: cloneNode(heap.root, nil, copyValue), size: heap.size, Comparator: heap.Comparator}
+}
+
+// Equal returns true if both heaps hold equal elements, i.e. they would pop the same elements in the same order,
+// apart from the order of elements the comparator considers equal.
+// Complexity is O(n log n), since the elements are sorted with the heap's comparator before comparing.
+// Elements are compared with utils.DefaultEquality.
+func (heap *Heap[T]) Equal(other *Heap[T]) bool {
+	if heap.Size() != other.Size() {
+		return false
+	}
+	return utils.SameElements(heap.Values(), other.Values(), heap.Comparator, utils.DefaultEquality[T]())
+}
+
+// Copies the node with its children and all following siblings, prev is the copy of the node's parent or previous sibling.
+func cloneNode[T any](node *Node[T], prev *Node[T], copyValue func(value T) T) *Node[T] {
+	var first *Node[T]
+	for ; node != nil; node = node.sibling {
+		clone := &Node[T]{value: copyValue(node.value), prev: prev}
+		clone.child = cloneNode(node.child, clone, copyValue)
+		if first == nil {
+			first = clone
+		} else {
+			prev.sibling = clone
+		}
+		prev = clone
+	}
+	return first
+}
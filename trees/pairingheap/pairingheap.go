@@ -0,0 +1,247 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pairingheap implements a pairing heap.
+//
+// A pairing heap is a heap-ordered multi-way tree with O(1) insert, meld and peek, O(log n) amortized pop and delete,
+// and o(log n) amortized decrease-key. Inserting returns a node, which can be used as a handle to decrease or delete the
+// value later on.
+//
+// Insert is the handle-returning variant of Push: Push keeps the signature of the trees.Heap interface, so that the heap
+// can be used wherever a Heap is expected, and does not return the nodes of the pushed values.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Pairing_heap
+package pairingheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ugurcsen/gods-generic/trees"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+
+// Heap holds the root of the heap-ordered tree
+type Heap[T any] struct {
+	root       *Node[T]
+	size       int
+	Comparator utils.Comparator[T]
+}
+
+// Node is a single element within the heap, returned by Insert as a handle to the value
+type Node[T any] struct {
+	value   T
+	child   *Node[T] // first child
+	sibling *Node[T] // next sibling
+	prev    *Node[T] // previous sibling or parent if this is the first child
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T any](comparator utils.Comparator[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// NewWithNumberComparator instantiates a new empty heap with the NumberComparator, i.e. elements are numbers.
+func NewWithNumberComparator[T utils.ComparableNumber]() *Heap[T] {
+	return &Heap[T]{Comparator: utils.NumberComparator[T]}
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Heap[string] {
+	return &Heap[string]{Comparator: utils.StringComparator}
+}
+
+// Value returns the value held by the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Insert adds a value onto the heap and returns its node, which can be used with DecreaseKey and Delete.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value}
+	heap.root = heap.link(heap.root, node)
+	heap.size++
+	return node
+}
+
+// Push adds values onto the heap.
+// Use Insert instead to get the nodes needed by DecreaseKey and Delete.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	if heap.root == nil {
+		return value, false
+	}
+	root := heap.root
+	heap.root = heap.mergePairs(root.child)
+	heap.size--
+	root.child = nil
+	return root.value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.root == nil {
+		return value, false
+	}
+	return heap.root.value, true
+}
+
+// Meld moves all elements of the other heap into this heap in O(1), leaving the other heap empty.
+// Nodes of the other heap remain valid handles within this heap.
+// Both heaps are expected to use the same comparator.
+func (heap *Heap[T]) Meld(other *Heap[T]) {
+	if heap == other {
+		return
+	}
+	heap.root = heap.link(heap.root, other.root)
+	heap.size += other.size
+	other.root = nil
+	other.size = 0
+}
+
+// DecreaseKey changes the value of the node to the given value, which must not be ordered after the current value,
+// i.e. it moves the node towards the top of the heap.
+// Returns false and does not change anything if the given value is ordered after the current value.
+// The node must be held by the heap.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if node != heap.root {
+		heap.cut(node)
+		heap.root = heap.link(heap.root, node)
+	}
+	return true
+}
+
+// Delete removes the node from the heap.
+// The node must be held by the heap.
+func (heap *Heap[T]) Delete(node *Node[T]) {
+	if node == heap.root {
+		heap.Pop()
+		return
+	}
+	heap.cut(node)
+	heap.root = heap.link(heap.root, heap.mergePairs(node.child))
+	heap.size--
+	node.child = nil
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.root = nil
+	heap.size = 0
+}
+
+// Values returns all elements in the heap, ordered from the top of the heap.
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	for stack := []*Node[T]{heap.root}; len(stack) > 0; {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for ; node != nil; node = node.sibling {
+			values = append(values, node.value)
+			stack = append(stack, node.child)
+		}
+	}
+	utils.Sort(values, heap.Comparator)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "PairingHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Links two trees by making the root ordered after the other the first child of the other root.
+// Either tree can be nil. Only the roots' sibling references must be unset.
+func (heap *Heap[T]) link(first, second *Node[T]) *Node[T] {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	if heap.Comparator(second.value, first.value) < 0 {
+		first, second = second, first
+	}
+	second.prev = first
+	second.sibling = first.child
+	if first.child != nil {
+		first.child.prev = second
+	}
+	first.child = second
+	first.prev = nil
+	return first
+}
+
+// Detaches the (non-root) node with its subtree from its parent or previous sibling.
+func (heap *Heap[T]) cut(node *Node[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+	node.prev = nil
+	node.sibling = nil
+}
+
+// Merges the list of siblings into a single tree using the standard two-pass pairing:
+// siblings are linked in pairs from left to right, then the pairs are linked from right to left.
+func (heap *Heap[T]) mergePairs(first *Node[T]) *Node[T] {
+	var pairs []*Node[T]
+	for first != nil {
+		second := first.sibling
+		if second == nil {
+			first.prev = nil
+			pairs = append(pairs, first)
+			break
+		}
+		next := second.sibling
+		first.prev, first.sibling = nil, nil
+		second.prev, second.sibling = nil, nil
+		pairs = append(pairs, heap.link(first, second))
+		first = next
+	}
+	var root *Node[T]
+	for index := len(pairs) - 1; index >= 0; index-- {
+		root = heap.link(pairs[index], root)
+	}
+	return root
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"math/bits"
+
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// FromSorted instantiates a red-black tree with the custom comparator from keys that are already
+// in ascending order without duplicates and their corresponding values, in O(n) without any rotations.
+// Panics if the slices are of different lengths or the keys are not strictly ascending.
+func FromSorted[K comparable, T any](comparator utils.Comparator[K], keys []K, values []T) *Tree[K, T] {
+	if len(keys) != len(values) {
+		panic("Invalid values, should be as many as keys")
+	}
+	if !ascending(comparator, keys) {
+		panic("Invalid keys, should be in ascending order without duplicates")
+	}
+	tree := NewWith[K, T](comparator)
+	tree.build(keys, values)
+	return tree
+}
+
+// FromSortedIterator instantiates a red-black tree with the custom comparator from the remaining elements
+// of the iterator, whose keys have to be in ascending order without duplicates, in O(n).
+// Panics if the keys are not strictly ascending.
+func FromSortedIterator[K comparable, T any](comparator utils.Comparator[K], it containers.IteratorWithKey[K, T]) *Tree[K, T] {
+	var keys []K
+	var values []T
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	return FromSorted(comparator, keys, values)
+}
+
+// build fills the empty tree with the sorted keys and values. Splitting at the middle key fills every level
+// but the deepest one, whose nodes are colored red, so that all paths have the same number of black nodes.
+func (tree *Tree[K, T]) build(keys []K, values []T) {
+	redDepth := bits.Len(uint(len(keys))) - 1
+	tree.Root = tree.buildSubtree(keys, values, nil, 0, redDepth)
+	if tree.Root != nil {
+		tree.Root.color = black
+	}
+	tree.size = len(keys)
+	tree.version++
+}
+
+func (tree *Tree[K, T]) buildSubtree(keys []K, values []T, parent *Node[K, T], depth, redDepth int) *Node[K, T] {
+	if len(keys) == 0 {
+		return nil
+	}
+	middle := len(keys) / 2
+	color := black
+	if depth == redDepth {
+		color = red
+	}
+	node := tree.newNode(keys[middle], values[middle], color)
+	node.Parent = parent
+	node.Left = tree.buildSubtree(keys[:middle], values[:middle], node, depth+1, redDepth)
+	node.Right = tree.buildSubtree(keys[middle+1:], values[middle+1:], node, depth+1, redDepth)
+	node.resize()
+	return node
+}
+
+// ascending returns true if the keys are in ascending order without duplicates
+func ascending[K any](comparator utils.Comparator[K], keys []K) bool {
+	for i := 1; i < len(keys); i++ {
+		if comparator(keys[i-1], keys[i]) >= 0 {
+			return false
+		}
+	}
+	return true
+}
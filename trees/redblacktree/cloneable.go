@@ -0,0 +1,61 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/internal/arena"
+	"github.com/ugurcsen/gods-generic/utils"
+)
+
+// Assert Cloneable implementation
+var _ containers.Cloneable[*Tree[int, int], int] = (*Tree[int, int])(nil)
+
+// Clone returns a copy of the tree with the same structure and node colors.
+// Runs in O(n) without any rebalancing.
+func (tree *Tree[K, T]) Clone() *Tree[K, T] {
+	return tree.DeepClone(func(value T) T { return value })
+}
+
+// DeepClone returns a copy of the tree where every value is passed through the given copy function.
+// Keys are copied by assignment. Runs in O(n) without any rebalancing.
+func (tree *Tree[K, T]) DeepClone(copyValue func(value T) T) *Tree[K, T] {
+	clone := &Tree[K, T]{
+		size:       tree.size,
+		Comparator: tree.Comparator,
+	}
+	if tree.nodes != nil {
+		clone.nodes = arena.New[Node[K, T]](tree.nodes.ChunkSize())
+	}
+	clone.Root = clone.cloneNode(tree.Root, nil, copyValue)
+	return clone
+}
+
+// Equal returns true if both trees hold the same keys and values in the same order.
+// Keys and values are compared with utils.DefaultEquality.
+func (tree *Tree[K, T]) Equal(other *Tree[K, T]) bool {
+	if tree.Size() != other.Size() {
+		return false
+	}
+	keyEqual, valueEqual := utils.DefaultEquality[K](), utils.DefaultEquality[T]()
+	it1, it2 := tree.Iterator(), other.Iterator()
+	for it1.Next() && it2.Next() {
+		if !keyEqual(it1.Key(), it2.Key()) || !valueEqual(it1.Value(), it2.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tree *Tree[K, T]) cloneNode(node *Node[K, T], parent *Node[K, T], copyValue func(value T) T) *Node[K, T] {
+	if node == nil {
+		return nil
+	}
+	clone := tree.newNode(node.Key, copyValue(node.Value), node.color)
+	clone.Parent, clone.size = parent, node.size
+	clone.Left = tree.cloneNode(node.Left, clone, copyValue)
+	clone.Right = tree.cloneNode(node.Right, clone, copyValue)
+	return clone
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"github.com/ugurcsen/gods-generic/internal/dot"
+)
+
+// ToDOT outputs the structure of the tree in the Graphviz DOT language, with the nodes filled in their colours.
+// Render it with e.g. "dot -Tsvg tree.dot > tree.svg".
+func (tree *Tree[K, T]) ToDOT() string {
+	graph := dot.New("RedBlackTree", "shape=circle, style=filled, fontcolor=white")
+	if tree.Root != nil {
+		outputDOT(graph, tree.Root)
+	}
+	return graph.String()
+}
+
+func outputDOT[K comparable, T any](graph *dot.Graph, node *Node[K, T]) int {
+	fill := "fillcolor=black"
+	if node.color == red {
+		fill = "fillcolor=red"
+	}
+	id := graph.Node(node.String(), fill)
+	if node.Left == nil && node.Right == nil {
+		return id
+	}
+	for _, child := range []*Node[K, T]{node.Left, node.Right} {
+		if child == nil {
+			graph.Placeholder(id)
+		} else {
+			graph.Edge(id, outputDOT(graph, child))
+		}
+	}
+	return id
+}
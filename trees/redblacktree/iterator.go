@@ -14,22 +14,25 @@ type Iterator[K comparable, T any] struct {
 	tree     *Tree[K, T]
 	node     *Node[K, T]
 	position position
+	version  int
+	key      K // key of the element removed through the iterator
 }
 
 type position byte
 
 const (
-	begin, between, end position = 0, 1, 2
+	begin, between, end, removed position = 0, 1, 2, 3
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator panics with containers.ErrConcurrentModification if the tree is modified during iteration.
 func (tree *Tree[K, T]) Iterator() Iterator[K, T] {
-	return Iterator[K, T]{tree: tree, node: nil, position: begin}
+	return Iterator[K, T]{tree: tree, node: nil, position: begin, version: tree.version}
 }
 
 // IteratorAt returns a stateful iterator whose elements are key/value pairs that is initialised at a particular node.
 func (tree *Tree[K, T]) IteratorAt(node *Node[K, T]) Iterator[K, T] {
-	return Iterator[K, T]{tree: tree, node: node, position: between}
+	return Iterator[K, T]{tree: tree, node: node, position: between, version: tree.version}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -37,9 +40,22 @@ func (tree *Tree[K, T]) IteratorAt(node *Node[K, T]) Iterator[K, T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Next() bool {
+	if iterator.position == begin {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	if iterator.position == end {
 		goto end
 	}
+	if iterator.position == removed {
+		// nodes may have been rearranged by the removal, look up the successor of the removed key
+		ceiling, found := iterator.tree.Ceiling(iterator.key)
+		if !found {
+			goto end
+		}
+		iterator.node = ceiling
+		goto between
+	}
 	if iterator.position == begin {
 		left := iterator.tree.Left()
 		if left == nil {
@@ -77,9 +93,21 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[K, T]) Prev() bool {
+	if iterator.position == end {
+		iterator.version = iterator.tree.version
+	}
+	iterator.checkVersion()
 	if iterator.position == begin {
 		goto begin
 	}
+	if iterator.position == removed {
+		floor, found := iterator.tree.Floor(iterator.key)
+		if !found {
+			goto begin
+		}
+		iterator.node = floor
+		goto between
+	}
 	if iterator.position == end {
 		right := iterator.tree.Right()
 		if right == nil {
@@ -116,18 +144,21 @@ between:
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Value() T {
+	iterator.checkVersion()
 	return iterator.node.Value
 }
 
 // Key returns the current element's key.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Key() K {
+	iterator.checkVersion()
 	return iterator.node.Key
 }
 
 // Node returns the current element's node.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[K, T]) Node() *Node[K, T] {
+	iterator.checkVersion()
 	return iterator.node
 }
 
@@ -136,6 +167,7 @@ func (iterator *Iterator[K, T]) Node() *Node[K, T] {
 func (iterator *Iterator[K, T]) Begin() {
 	iterator.node = nil
 	iterator.position = begin
+	iterator.version = iterator.tree.version
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -143,6 +175,7 @@ func (iterator *Iterator[K, T]) Begin() {
 func (iterator *Iterator[K, T]) End() {
 	iterator.node = nil
 	iterator.position = end
+	iterator.version = iterator.tree.version
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -188,3 +221,39 @@ func (iterator *Iterator[K, T]) PrevTo(f func(key K, value T) bool) bool {
 	}
 	return false
 }
+
+// Remove removes the current element from the tree and returns true if there was a current element.
+// Afterwards Next() moves the iterator to the element that followed the removed one and Prev() to the one that
+// preceded it, Key() and Value() are undefined until then.
+// Removing through the iterator is the only modification of the tree that does not invalidate the iterator.
+// Modifies the state of the iterator.
+func (iterator *Iterator[K, T]) Remove() bool {
+	iterator.checkVersion()
+	if iterator.position != between {
+		return false
+	}
+	iterator.key = iterator.node.Key
+	iterator.tree.removeNode(iterator.node)
+	iterator.node = nil
+	iterator.position = removed
+	iterator.version = iterator.tree.version
+	return true
+}
+
+// Set replaces the value of the current element and returns true if there was a current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[K, T]) Set(value T) bool {
+	iterator.checkVersion()
+	if iterator.position != between {
+		return false
+	}
+	iterator.node.Value = value
+	return true
+}
+
+// checkVersion panics if the tree was modified since the iterator was created or reset.
+func (iterator *Iterator[K, T]) checkVersion() {
+	if iterator.version != iterator.tree.version {
+		panic(containers.ErrConcurrentModification)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"github.com/ugurcsen/gods-generic/internal/arena"
+)
+
+// Split, Union, Intersection and Difference are built on join, which links two trees and a middle node
+// in time proportional to the difference of their black heights, as described in
+// "Just Join for Parallel Ordered Sets" by Blelloch, Ferizovic and Sun.
+// Subtrees are passed around detached, i.e. without parent, together with their black height,
+// the number of black nodes on any path from their root down to a leaf, to avoid recomputing it.
+
+// Split moves the nodes with keys smaller than the given key to the left tree and the other nodes to the right tree
+// in O(log n) and leaves the tree empty. Both trees use the comparator of the tree.
+func (tree *Tree[K, T]) Split(key K) (left *Tree[K, T], right *Tree[K, T]) {
+	left, right = tree.newTree(), tree.newTree()
+	left.nodes, tree.nodes = tree.nodes, left.nodes
+	leftRoot, _, found, rightRoot, rightHeight := tree.split(tree.Root, blackHeight(tree.Root), key)
+	if found != nil {
+		rightRoot, _ = join(nil, 0, found, rightRoot, rightHeight)
+	}
+	left.setRoot(leftRoot)
+	right.setRoot(rightRoot)
+	tree.setRoot(nil)
+	return left, right
+}
+
+// Union moves the nodes of the other tree into the tree and leaves the other tree empty.
+// The tree keeps its values of the keys found in both trees. Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (tree *Tree[K, T]) Union(other *Tree[K, T]) {
+	if other == tree {
+		return
+	}
+	root, _ := tree.union(tree.Root, blackHeight(tree.Root), other.Root, blackHeight(other.Root))
+	tree.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Intersection removes the nodes whose keys are not in the other tree from the tree and leaves the other tree empty.
+// Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (tree *Tree[K, T]) Intersection(other *Tree[K, T]) {
+	if other == tree {
+		return
+	}
+	root, _ := tree.intersection(tree.Root, blackHeight(tree.Root), other.Root, blackHeight(other.Root))
+	tree.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Difference removes the nodes whose keys are in the other tree from the tree and leaves the other tree empty.
+// Both trees must use the same comparator.
+// Runs in O(m log(n/m + 1)) where m is the size of the smaller and n the size of the larger tree.
+func (tree *Tree[K, T]) Difference(other *Tree[K, T]) {
+	if other == tree {
+		tree.Clear()
+		return
+	}
+	root, _ := tree.difference(tree.Root, blackHeight(tree.Root), other.Root, blackHeight(other.Root))
+	tree.setRoot(root)
+	other.setRoot(nil)
+}
+
+// Returns an empty tree with the comparator of the tree and an arena of the same chunk size if the tree has one
+func (tree *Tree[K, T]) newTree() *Tree[K, T] {
+	other := &Tree[K, T]{Comparator: tree.Comparator}
+	if tree.nodes != nil {
+		other.nodes = arena.New[Node[K, T]](tree.nodes.ChunkSize())
+	}
+	return other
+}
+
+// Replaces the nodes of the tree by the detached subtree
+func (tree *Tree[K, T]) setRoot(root *Node[K, T]) {
+	tree.Root = root
+	tree.size = root.Size()
+	if root != nil {
+		root.Parent = nil
+		root.color = black
+	}
+	tree.version++
+}
+
+// split splits the subtree into the subtrees with the keys smaller and larger than the key
+// and returns them with their black heights and the detached node with the key, if any.
+func (tree *Tree[K, T]) split(node *Node[K, T], height int, key K) (*Node[K, T], int, *Node[K, T], *Node[K, T], int) {
+	if node == nil {
+		return nil, 0, nil, nil, 0
+	}
+	left, right, childHeight := detach(node, height)
+	compare := tree.Comparator(key, node.Key)
+	switch {
+	case compare < 0:
+		smaller, smallerHeight, found, larger, largerHeight := tree.split(left, childHeight, key)
+		larger, largerHeight = join(larger, largerHeight, node, right, childHeight)
+		return smaller, smallerHeight, found, larger, largerHeight
+	case compare > 0:
+		smaller, smallerHeight, found, larger, largerHeight := tree.split(right, childHeight, key)
+		smaller, smallerHeight = join(left, childHeight, node, smaller, smallerHeight)
+		return smaller, smallerHeight, found, larger, largerHeight
+	default:
+		return left, childHeight, node, right, childHeight
+	}
+}
+
+// union merges the subtrees, freeing the nodes of the second one whose keys are in the first one.
+func (tree *Tree[K, T]) union(node1 *Node[K, T], height1 int, node2 *Node[K, T], height2 int) (*Node[K, T], int) {
+	if node1 == nil {
+		return node2, height2
+	}
+	if node2 == nil {
+		return node1, height1
+	}
+	left1, right1, childHeight := detach(node1, height1)
+	left2, leftHeight2, found, right2, rightHeight2 := tree.split(node2, height2, node1.Key)
+	if found != nil {
+		tree.freeNode(found)
+	}
+	left, leftHeight := tree.union(left1, childHeight, left2, leftHeight2)
+	right, rightHeight := tree.union(right1, childHeight, right2, rightHeight2)
+	return join(left, leftHeight, node1, right, rightHeight)
+}
+
+// intersection keeps the nodes of the first subtree whose keys are in the second one.
+func (tree *Tree[K, T]) intersection(node1 *Node[K, T], height1 int, node2 *Node[K, T], height2 int) (*Node[K, T], int) {
+	if node1 == nil || node2 == nil {
+		return nil, 0
+	}
+	left1, right1, childHeight := detach(node1, height1)
+	left2, leftHeight2, found, right2, rightHeight2 := tree.split(node2, height2, node1.Key)
+	left, leftHeight := tree.intersection(left1, childHeight, left2, leftHeight2)
+	right, rightHeight := tree.intersection(right1, childHeight, right2, rightHeight2)
+	if found == nil {
+		tree.freeNode(node1)
+		return merge(left, leftHeight, right, rightHeight)
+	}
+	tree.freeNode(found)
+	return join(left, leftHeight, node1, right, rightHeight)
+}
+
+// difference keeps the nodes of the first subtree whose keys are not in the second one.
+func (tree *Tree[K, T]) difference(node1 *Node[K, T], height1 int, node2 *Node[K, T], height2 int) (*Node[K, T], int) {
+	if node1 == nil || node2 == nil {
+		return node1, height1
+	}
+	left2, right2, childHeight := detach(node2, height2)
+	left1, leftHeight1, found, right1, rightHeight1 := tree.split(node1, height1, node2.Key)
+	if found != nil {
+		tree.freeNode(found)
+	}
+	left, leftHeight := tree.difference(left1, leftHeight1, left2, childHeight)
+	right, rightHeight := tree.difference(right1, rightHeight1, right2, childHeight)
+	return merge(left, leftHeight, right, rightHeight)
+}
+
+// join links the subtrees to the node, whose key is larger than the keys of the left and smaller than the keys of
+// the right subtree, and returns the root of the joined subtree, which may be red, and its black height.
+// The roots of the subtrees are colored black first, so that a red root returned by joinRight or joinLeft
+// never has a red child.
+func join[K comparable, T any](left *Node[K, T], leftHeight int, node *Node[K, T], right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	leftHeight = blacken(left, leftHeight)
+	rightHeight = blacken(right, rightHeight)
+	switch {
+	case leftHeight > rightHeight:
+		root := joinRight(left, leftHeight, node, right, rightHeight)
+		root.Parent = nil
+		return root, leftHeight
+	case leftHeight < rightHeight:
+		root := joinLeft(left, leftHeight, node, right, rightHeight)
+		root.Parent = nil
+		return root, rightHeight
+	default:
+		link(node, left, right, red)
+		node.Parent = nil
+		return node, leftHeight
+	}
+}
+
+// joinRight descends the right spine of the higher left subtree down to a black node with the black height
+// of the right subtree and replaces it by the red node linking both. A resulting red node with a red right child
+// is fixed at its black parent by a left rotation, pushing the violation up the spine.
+func joinRight[K comparable, T any](left *Node[K, T], leftHeight int, node *Node[K, T], right *Node[K, T], rightHeight int) *Node[K, T] {
+	if nodeColor(left) == black && leftHeight == rightHeight {
+		link(node, left, right, red)
+		return node
+	}
+	childHeight := leftHeight
+	if left.color == black {
+		childHeight--
+	}
+	child := joinRight(left.Right, childHeight, node, right, rightHeight)
+	left.Right, child.Parent = child, left
+	left.resize()
+	if left.color == black && child.color == red && nodeColor(child.Right) == red {
+		child.Right.color = black
+		return leftRotation(left)
+	}
+	return left
+}
+
+// joinLeft is the mirror image of joinRight for a higher right subtree.
+func joinLeft[K comparable, T any](left *Node[K, T], leftHeight int, node *Node[K, T], right *Node[K, T], rightHeight int) *Node[K, T] {
+	if nodeColor(right) == black && leftHeight == rightHeight {
+		link(node, left, right, red)
+		return node
+	}
+	childHeight := rightHeight
+	if right.color == black {
+		childHeight--
+	}
+	child := joinLeft(left, leftHeight, node, right.Left, childHeight)
+	right.Left, child.Parent = child, right
+	right.resize()
+	if right.color == black && child.color == red && nodeColor(child.Left) == red {
+		child.Left.color = black
+		return rightRotation(right)
+	}
+	return right
+}
+
+// merge joins the subtrees, where all keys of the left subtree are smaller than the keys of the right subtree,
+// using the maximum node of the left subtree as the middle node.
+func merge[K comparable, T any](left *Node[K, T], leftHeight int, right *Node[K, T], rightHeight int) (*Node[K, T], int) {
+	if left == nil {
+		return right, rightHeight
+	}
+	if right == nil {
+		return left, leftHeight
+	}
+	left, leftHeight, last := splitLast(left, leftHeight)
+	return join(left, leftHeight, last, right, rightHeight)
+}
+
+// splitLast detaches the maximum node from the subtree and returns the rest of the subtree and its black height.
+func splitLast[K comparable, T any](node *Node[K, T], height int) (*Node[K, T], int, *Node[K, T]) {
+	left, right, childHeight := detach(node, height)
+	if right == nil {
+		return left, childHeight, node
+	}
+	rest, restHeight, last := splitLast(right, childHeight)
+	root, rootHeight := join(left, childHeight, node, rest, restHeight)
+	return root, rootHeight, last
+}
+
+// detach unlinks the children of the node and returns them with their black height.
+func detach[K comparable, T any](node *Node[K, T], height int) (*Node[K, T], *Node[K, T], int) {
+	left, right := node.Left, node.Right
+	node.Left, node.Right = nil, nil
+	if left != nil {
+		left.Parent = nil
+	}
+	if right != nil {
+		right.Parent = nil
+	}
+	if node.color == black {
+		height--
+	}
+	return left, right, height
+}
+
+// link sets the children and the color of the node and updates its size.
+func link[K comparable, T any](node *Node[K, T], left *Node[K, T], right *Node[K, T], c color) {
+	node.Left, node.Right, node.color = left, right, c
+	if left != nil {
+		left.Parent = node
+	}
+	if right != nil {
+		right.Parent = node
+	}
+	node.resize()
+}
+
+// blacken colors a red root of a subtree black and returns the new black height.
+func blacken[K comparable, T any](node *Node[K, T], height int) int {
+	if node != nil && node.color == red {
+		node.color = black
+		height++
+	}
+	return height
+}
+
+// blackHeight counts the black nodes on the leftmost path of the subtree.
+func blackHeight[K comparable, T any](node *Node[K, T]) int {
+	height := 0
+	for ; node != nil; node = node.Left {
+		if node.color == black {
+			height++
+		}
+	}
+	return height
+}
+
+// leftRotation rotates the detached subtree to the left and returns its new root.
+// Unlike Tree.rotateLeft it does not update the root of the tree.
+func leftRotation[K comparable, T any](node *Node[K, T]) *Node[K, T] {
+	right := node.Right
+	node.Right = right.Left
+	if right.Left != nil {
+		right.Left.Parent = node
+	}
+	right.Left, right.Parent, node.Parent = node, node.Parent, right
+	node.resize()
+	right.resize()
+	return right
+}
+
+// rightRotation rotates the detached subtree to the right and returns its new root.
+// Unlike Tree.rotateRight it does not update the root of the tree.
+func rightRotation[K comparable, T any](node *Node[K, T]) *Node[K, T] {
+	left := node.Left
+	node.Left = left.Right
+	if left.Right != nil {
+		left.Right.Parent = node
+	}
+	left.Right, left.Parent, node.Parent = node, node.Parent, left
+	node.resize()
+	left.resize()
+	return left
+}
@@ -14,6 +14,7 @@ package redblacktree
 import (
 	"fmt"
 
+	"github.com/ugurcsen/gods-generic/internal/arena"
 	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/utils"
 )
@@ -30,8 +31,10 @@ const (
 // Tree holds elements of the red-black tree
 type Tree[K comparable, T any] struct {
 	Root       *Node[K, T]
-	size       int
+	size       int // number of nodes
 	Comparator utils.Comparator[K]
+	version    int                      // incremented on every modification, used by iterators to detect concurrent modification
+	nodes      *arena.Arena[Node[K, T]] // allocates the nodes if set, otherwise they are allocated one by one
 }
 
 // Node is a single element within the tree
@@ -39,6 +42,7 @@ type Node[K comparable, T any] struct {
 	Key    K
 	Value  T
 	color  color
+	size   int // number of nodes in the subtree rooted at the node
 	Left   *Node[K, T]
 	Right  *Node[K, T]
 	Parent *Node[K, T]
@@ -49,6 +53,14 @@ func NewWith[K comparable, T any](comparator utils.Comparator[K]) *Tree[K, T] {
 	return &Tree[K, T]{Comparator: comparator}
 }
 
+// NewWithArena instantiates a red-black tree with the custom comparator, whose nodes are allocated chunkSize at a time
+// and reused after removal. This reduces the number of heap objects, and thus the garbage collection work, of large
+// trees. A chunk is only reclaimed once all of its nodes are removed, and nodes obtained through GetNode, Floor,
+// Ceiling, etc. must not be used after their removal. A chunk size of one only reuses removed nodes.
+func NewWithArena[K comparable, T any](comparator utils.Comparator[K], chunkSize int) *Tree[K, T] {
+	return &Tree[K, T]{Comparator: comparator, nodes: arena.New[Node[K, T]](chunkSize)}
+}
+
 // NewWithNumberComparator instantiates a red-black tree with the IntComparator, i.e. keys are of type int.
 func NewWithNumberComparator[T any]() *Tree[int, T] {
 	return &Tree[int, T]{Comparator: utils.NumberComparator[int]}
@@ -66,7 +78,7 @@ func (tree *Tree[K, T]) Put(key K, value T) {
 	if tree.Root == nil {
 		// Assert key is of comparator's type for initial tree
 		tree.Comparator(key, key)
-		tree.Root = &Node[K, T]{Key: key, Value: value, color: red}
+		tree.Root = tree.newNode(key, value, red)
 		insertedNode = tree.Root
 	} else {
 		node := tree.Root
@@ -80,7 +92,7 @@ func (tree *Tree[K, T]) Put(key K, value T) {
 				return
 			case compare < 0:
 				if node.Left == nil {
-					node.Left = &Node[K, T]{Key: key, Value: value, color: red}
+					node.Left = tree.newNode(key, value, red)
 					insertedNode = node.Left
 					loop = false
 				} else {
@@ -88,7 +100,7 @@ func (tree *Tree[K, T]) Put(key K, value T) {
 				}
 			case compare > 0:
 				if node.Right == nil {
-					node.Right = &Node[K, T]{Key: key, Value: value, color: red}
+					node.Right = tree.newNode(key, value, red)
 					insertedNode = node.Right
 					loop = false
 				} else {
@@ -97,9 +109,13 @@ func (tree *Tree[K, T]) Put(key K, value T) {
 			}
 		}
 		insertedNode.Parent = node
+		for ; node != nil; node = node.Parent {
+			node.size++
+		}
 	}
 	tree.insertCase1(insertedNode)
 	tree.size++
+	tree.version++
 }
 
 // Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
@@ -123,11 +139,17 @@ func (tree *Tree[K, T]) GetNode(key K) *Node[K, T] {
 // Remove remove the node from the tree by key.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree[K, T]) Remove(key K) {
-	var child *Node[K, T]
 	node := tree.lookup(key)
 	if node == nil {
 		return
 	}
+	tree.removeNode(node)
+}
+
+// removeNode removes the node from the tree.
+// The node's key and value may be replaced by its predecessor's, which is unlinked instead.
+func (tree *Tree[K, T]) removeNode(node *Node[K, T]) {
+	var child *Node[K, T]
 	if node.Left != nil && node.Right != nil {
 		pred := node.Left.maximumNode()
 		node.Key = pred.Key
@@ -144,17 +166,22 @@ func (tree *Tree[K, T]) Remove(key K) {
 			node.color = nodeColor(child)
 			tree.deleteCase1(node)
 		}
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
 		tree.replaceNode(node, child)
 		if node.Parent == nil && child != nil {
 			child.color = black
 		}
 	}
+	tree.freeNode(node)
 	tree.size--
+	tree.version++
 }
 
 // Empty returns true if tree does not contain any nodes
 func (tree *Tree[K, T]) Empty() bool {
-	return tree.size == 0
+	return tree.Root == nil
 }
 
 // Size returns number of nodes in the tree.
@@ -163,24 +190,16 @@ func (tree *Tree[K, T]) Size() int {
 }
 
 // Size returns the number of elements stored in the subtree.
-// Computed dynamically on each call, i.e. the subtree is traversed to count the number of the nodes.
 func (node *Node[K, T]) Size() int {
 	if node == nil {
 		return 0
 	}
-	size := 1
-	if node.Left != nil {
-		size += node.Left.Size()
-	}
-	if node.Right != nil {
-		size += node.Right.Size()
-	}
-	return size
+	return node.size
 }
 
 // Keys returns all keys in-order
 func (tree *Tree[K, T]) Keys() []K {
-	keys := make([]K, tree.size)
+	keys := make([]K, tree.Size())
 	it := tree.Iterator()
 	for i := 0; it.Next(); i++ {
 		keys[i] = it.Key()
@@ -190,7 +209,7 @@ func (tree *Tree[K, T]) Keys() []K {
 
 // Values returns all values in-order based on the key.
 func (tree *Tree[K, T]) Values() []T {
-	values := make([]T, tree.size)
+	values := make([]T, tree.Size())
 	it := tree.Iterator()
 	for i := 0; it.Next(); i++ {
 		values[i] = it.Value()
@@ -278,10 +297,56 @@ func (tree *Tree[K, T]) Ceiling(key K) (ceiling *Node[K, T], found bool) {
 	return nil, false
 }
 
+// Lower finds the lower node of the input key, return the lower node or nil if no lower node is found.
+// Second return parameter is true if lower node was found, otherwise false.
+//
+// Lower node is defined as the largest node that is strictly smaller than the given node.
+// A lower node may not be found, either because the tree is empty, or because
+// all nodes in the tree are larger than or equal to the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, T]) Lower(key K) (lower *Node[K, T], found bool) {
+	node := tree.Root
+	for node != nil {
+		if tree.Comparator(key, node.Key) > 0 {
+			lower, found = node, true
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return lower, found
+}
+
+// Higher finds the higher node of the input key, return the higher node or nil if no higher node is found.
+// Second return parameter is true if higher node was found, otherwise false.
+//
+// Higher node is defined as the smallest node that is strictly larger than the given node.
+// A higher node may not be found, either because the tree is empty, or because
+// all nodes in the tree are smaller than or equal to the given node.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree[K, T]) Higher(key K) (higher *Node[K, T], found bool) {
+	node := tree.Root
+	for node != nil {
+		if tree.Comparator(key, node.Key) < 0 {
+			higher, found = node, true
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return higher, found
+}
+
 // Clear removes all nodes from the tree.
 func (tree *Tree[K, T]) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.version++
+	if tree.nodes != nil {
+		tree.nodes.Reset()
+	}
 }
 
 // String returns a string representation of container
@@ -341,6 +406,23 @@ func (tree *Tree[K, T]) lookup(key K) *Node[K, T] {
 	return nil
 }
 
+// Allocates an unlinked node, from the arena if the tree has one
+func (tree *Tree[K, T]) newNode(key K, value T, color color) *Node[K, T] {
+	if tree.nodes == nil {
+		return &Node[K, T]{Key: key, Value: value, color: color, size: 1}
+	}
+	node := tree.nodes.Alloc()
+	node.Key, node.Value, node.color, node.size = key, value, color, 1
+	return node
+}
+
+// Returns the removed node to the arena if the tree has one
+func (tree *Tree[K, T]) freeNode(node *Node[K, T]) {
+	if tree.nodes != nil {
+		tree.nodes.Free(node)
+	}
+}
+
 func (node *Node[K, T]) grandparent() *Node[K, T] {
 	if node != nil && node.Parent != nil {
 		return node.Parent.Parent
@@ -374,6 +456,8 @@ func (tree *Tree[K, T]) rotateLeft(node *Node[K, T]) {
 	}
 	right.Left = node
 	node.Parent = right
+	node.resize()
+	right.resize()
 }
 
 func (tree *Tree[K, T]) rotateRight(node *Node[K, T]) {
@@ -385,6 +469,8 @@ func (tree *Tree[K, T]) rotateRight(node *Node[K, T]) {
 	}
 	left.Right = node
 	node.Parent = left
+	node.resize()
+	left.resize()
 }
 
 func (tree *Tree[K, T]) replaceNode(old *Node[K, T], new *Node[K, T]) {
@@ -452,6 +538,11 @@ func (tree *Tree[K, T]) insertCase5(node *Node[K, T]) {
 	}
 }
 
+// Recomputes the size of the node from the sizes of its children
+func (node *Node[K, T]) resize() {
+	node.size = 1 + node.Left.Size() + node.Right.Size()
+}
+
 func (node *Node[K, T]) maximumNode() *Node[K, T] {
 	if node == nil {
 		return nil
@@ -6,8 +6,13 @@ package redblacktree
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/ugurcsen/gods-generic/containers"
+	"github.com/ugurcsen/gods-generic/containers/containertest"
+	"github.com/ugurcsen/gods-generic/trees"
 	"github.com/ugurcsen/gods-generic/utils"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -233,6 +238,41 @@ func TestRedBlackTreeCeilingAndFloor(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeLowerAndHigher(t *testing.T) {
+	tree := NewWith[int, string](utils.NumberComparator[int])
+
+	if node, found := tree.Lower(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+	if node, found := tree.Higher(0); node != nil || found {
+		t.Errorf("Got %v expected %v", node, "<nil>")
+	}
+
+	tree.Put(5, "e")
+	tree.Put(1, "a")
+	tree.Put(3, "c")
+	tree.Put(7, "g")
+
+	// key, expectedLower, expectedHigher (0 if not found)
+	tests := [][]int{
+		{0, 0, 1},
+		{1, 0, 3},
+		{2, 1, 3},
+		{3, 1, 5},
+		{6, 5, 7},
+		{7, 5, 0},
+		{8, 7, 0},
+	}
+	for _, test := range tests {
+		if node, found := tree.Lower(test[0]); found != (test[1] != 0) || found && node.Key != test[1] {
+			t.Errorf("Got %v expected %v for lower of %v", node, test[1], test[0])
+		}
+		if node, found := tree.Higher(test[0]); found != (test[2] != 0) || found && node.Key != test[2] {
+			t.Errorf("Got %v expected %v for higher of %v", node, test[2], test[0])
+		}
+	}
+}
+
 func TestRedBlackTreeIteratorNextOnEmpty(t *testing.T) {
 	tree := NewWithNumberComparator[string]()
 	it := tree.Iterator()
@@ -706,6 +746,587 @@ func TestRedBlackTreeString(t *testing.T) {
 	}
 }
 
+func TestRedBlackTreeClone(t *testing.T) {
+	tree := NewWithNumberComparator[string]()
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, fmt.Sprintf("%d", i))
+	}
+	clone := tree.Clone()
+	if actualValue, expectedValue := clone.String(), tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := clone.Equal(tree); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for i := 1; i <= 10; i++ {
+		clone.Remove(i)
+	}
+	clone.Put(21, "21")
+	if actualValue, expectedValue := tree.Size(), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Size(), 11; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := 1; i <= 20; i++ {
+		if actualValue, found := tree.Get(i); actualValue != fmt.Sprintf("%d", i) || !found {
+			t.Errorf("Got %v expected %v", actualValue, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%d%d", clone.Keys()[0], clone.Keys()[10]), "1121"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	deepClone := tree.DeepClone(func(value string) string { return value + "!" })
+	if actualValue, expectedValue := deepClone.Size(), 20; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := deepClone.Get(7); actualValue != "7!" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "7!")
+	}
+	if actualValue := deepClone.Equal(tree); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	empty := NewWithNumberComparator[string]().Clone()
+	if actualValue := empty.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestRedBlackTreeEqual(t *testing.T) {
+	tree := NewWithNumberComparator[string]()
+	other := NewWithNumberComparator[string]()
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, "x")
+		other.Put(11-i, "x")
+	}
+	if actualValue := tree.Equal(other); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	other.Put(5, "y")
+	if actualValue := tree.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	other.Remove(5)
+	if actualValue := tree.Equal(other); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestRedBlackTreeIteratorConcurrentModification(t *testing.T) {
+	assertConcurrentModification := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != containers.ErrConcurrentModification {
+				t.Errorf("Got %v expected %v", r, containers.ErrConcurrentModification)
+			}
+		}()
+		f()
+	}
+	tree := NewWithNumberComparator[string]()
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+	tree.Put(3, "c")
+	it := tree.Iterator()
+	it.Next()
+	tree.Put(1, "x") // replacing a value is not a modification
+	if actualValue, expectedValue := it.Value(), "x"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put(4, "d")
+	assertConcurrentModification(func() { it.Next() })
+	assertConcurrentModification(func() { it.Key() })
+
+	it.End()
+	tree.Remove(1) // an iterator past the last element is not affected
+	it.Prev()
+	if actualValue, expectedValue := it.Key(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Remove(3)
+	assertConcurrentModification(func() { it.Prev() })
+
+	it.Begin()
+	tree.Remove(4) // an iterator before the first element is not affected
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put(5, "e")
+	assertConcurrentModification(func() { it.Next() })
+
+	it.Begin()
+	for it.Next() {
+	}
+	tree.Put(6, "f") // an iterator that ran past the last element is not affected either
+	it.Prev()
+	if actualValue, expectedValue := it.Key(), 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for it.Prev() {
+	}
+	tree.Put(0, "z")
+	it.Next()
+	if actualValue, expectedValue := it.Key(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Clear()
+	assertConcurrentModification(func() { it.Next() })
+}
+
+func TestRedBlackTreeIteratorRemove(t *testing.T) {
+	tree := NewWithNumberComparator[int]()
+	for i := 0; i < 100; i++ {
+		key := (i * 37) % 100 // insertion order that yields nodes with two children
+		tree.Put(key, key)
+	}
+	it := tree.Iterator()
+	expected := 0
+	for it.Next() {
+		if actualValue, expectedValue := it.Key(), expected; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if it.Key()%3 != 0 {
+			it.Remove()
+		} else {
+			it.Set(-it.Value())
+		}
+		expected++
+	}
+	if actualValue, expectedValue := tree.Size(), 34; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	expected = 99
+	for it.Prev() {
+		if actualValue, expectedValue := it.Key(), expected; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := it.Value(), -expected; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if it.Key()%2 != 0 {
+			it.Remove()
+		}
+		expected -= 3
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys()), "[0 6 12 18 24 30 36 42 48 54 60 66 72 78 84 90 96]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Node(), (*Node[int, int])(nil); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestRedBlackTreeArena(t *testing.T) {
+	tree, expected := NewWithArena[int, int](utils.NumberComparator[int], 8), NewWithNumberComparator[int]()
+	for i := 0; i < 1000; i++ {
+		key := (i * 7919) % 500 // revisits keys, so that some puts update existing ones
+		if i%3 == 2 {
+			tree.Remove(key)
+			expected.Remove(key)
+		} else {
+			tree.Put(key, i)
+			expected.Put(key, i)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), fmt.Sprint(expected.Keys(), expected.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Size(), expected.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	clone := tree.Clone()
+	tree.Clear()
+	if actualValue, expectedValue := fmt.Sprint(clone.Keys()), fmt.Sprint(expected.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// removed nodes are reused, so that refilling the tree does not allocate
+	for i := 0; i < 100; i++ {
+		tree.Put(i, i)
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 100; i++ {
+			tree.Remove(i)
+		}
+		for i := 0; i < 100; i++ {
+			tree.Put(i, i)
+		}
+	})
+	if actualValue, expectedValue := allocs, 0.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTreeIteratorConformance(t *testing.T) {
+	containertest.TestIteratorWithKey(t, func(keys []int, values []string) containers.IteratorWithKey[int, string] {
+		tree := NewWithNumberComparator[string]()
+		for index, key := range keys {
+			tree.Put(key, values[index])
+		}
+		it := tree.Iterator()
+		return &it
+	})
+}
+
+func TestRedBlackTreeToDOT(t *testing.T) {
+	tree := NewWithNumberComparator[string]()
+	if actualValue, expectedValue := tree.ToDOT(), "digraph \"RedBlackTree\" {\n\tnode [shape=circle, style=filled, fontcolor=white];\n}\n"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Put(2, "b")
+	tree.Put(1, "a")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	dot := tree.ToDOT()
+	for _, expectedValue := range []string{
+		`n0 [label="2", fillcolor=black];`,
+		`n1 [label="1", fillcolor=black];`,
+		`n2 [label="3", fillcolor=black];`,
+		`n3 [label="", style=invis];`,
+		`n4 [label="4", fillcolor=red];`,
+		"n0 -> n1;",
+		"n2 -> n3 [style=invis];",
+		"n2 -> n4;",
+		"n0 -> n2;",
+	} {
+		if !strings.Contains(dot, expectedValue) {
+			t.Errorf("Got %v expected to contain %v", dot, expectedValue)
+		}
+	}
+}
+
+func TestRedBlackTreeValidate(t *testing.T) {
+	for _, tree := range []*Tree[int, int]{NewWithNumberComparator[int](), NewWithArena[int, int](utils.NumberComparator[int], 16)} {
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < 2000; i++ {
+			if key := r.Intn(200); r.Intn(3) == 0 {
+				tree.Remove(key)
+			} else {
+				tree.Put(key, i)
+			}
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("Got error %v after %d operations", err, i+1)
+			}
+		}
+	}
+
+	// corruptions of a valid tree and whether they are detected
+	tests := []struct {
+		name    string
+		corrupt func(tree *Tree[int, int])
+	}{
+		{"red root", func(tree *Tree[int, int]) { tree.Root.color = red }},
+		{"red child of red node", func(tree *Tree[int, int]) { redNode(tree.Root).Parent.color = red }},
+		{"black height", func(tree *Tree[int, int]) { redNode(tree.Root).color = black }},
+		{"key order", func(tree *Tree[int, int]) { tree.Root.Left.Key = 100 }},
+		{"parent link", func(tree *Tree[int, int]) { tree.Root.Right.Parent = nil }},
+		{"size", func(tree *Tree[int, int]) { tree.size++ }},
+		{"subtree size", func(tree *Tree[int, int]) { tree.Root.Left.size++ }},
+	}
+	for _, test := range tests {
+		tree := NewWithNumberComparator[int]()
+		for i := 1; i <= 10; i++ {
+			tree.Put(i, i)
+		}
+		test.corrupt(tree)
+		if err := tree.Validate(); !errors.Is(err, trees.ErrInvalid) {
+			t.Errorf("Got %v expected %v for %v", err, trees.ErrInvalid, test.name)
+		}
+	}
+}
+
+func TestRedBlackTreeSplit(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 10, 100, 1000} {
+		for _, key := range []int{-1, 0, 1, size / 2, size, size + 1, 2 * size, 2*size + 1} {
+			for _, tree := range []*Tree[int, int]{NewWithNumberComparator[int](), NewWithArena[int, int](utils.NumberComparator[int], 16)} {
+				for i := 0; i < size; i++ {
+					tree.Put(2*i, i) // even keys, so that odd keys split between nodes
+				}
+				left, right := tree.Split(key)
+				if actualValue, expectedValue := tree.Size(), 0; actualValue != expectedValue {
+					t.Errorf("Got %v expected %v", actualValue, expectedValue)
+				}
+				var expectedLeft, expectedRight []int
+				for i := 0; i < size; i++ {
+					if 2*i < key {
+						expectedLeft = append(expectedLeft, 2*i)
+					} else {
+						expectedRight = append(expectedRight, 2*i)
+					}
+				}
+				for _, test := range []struct {
+					tree     *Tree[int, int]
+					expected []int
+				}{{left, expectedLeft}, {right, expectedRight}} {
+					if err := test.tree.Validate(); err != nil {
+						t.Fatalf("Got error %v splitting %d keys at %d", err, size, key)
+					}
+					if actualValue, expectedValue := fmt.Sprint(test.tree.Keys()), fmt.Sprint(test.expected); actualValue != expectedValue {
+						t.Errorf("Got %v expected %v", actualValue, expectedValue)
+					}
+					if actualValue, expectedValue := test.tree.Size(), len(test.expected); actualValue != expectedValue {
+						t.Errorf("Got %v expected %v", actualValue, expectedValue)
+					}
+				}
+			}
+		}
+	}
+
+	// the sizes stay correct when the trees are modified before counting them
+	tree := NewWithNumberComparator[int]()
+	for i := 0; i < 10; i++ {
+		tree.Put(i, i)
+	}
+	left, right := tree.Split(5)
+	left.Put(10, 10)
+	left.Put(0, 0)
+	right.Remove(5)
+	if actualValue, expectedValue := fmt.Sprint(left.Size(), right.Size()), "6 4"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := left.Validate(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestRedBlackTreeSplitLarge(t *testing.T) {
+	const size = 1 << 16
+	tree := NewWithNumberComparator[int]()
+	for i := 0; i < size; i++ {
+		tree.Put(i, i)
+	}
+	// shards and re-merges the tree, the sizes of both halves follow from the sizes of the subtrees
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		key := r.Intn(size+2) - 1
+		left, right := tree.Split(key)
+		expectedLeft := min(max(key, 0), size)
+		if actualValue, expectedValue := fmt.Sprint(left.Size(), right.Size()), fmt.Sprint(expectedLeft, size-expectedLeft); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v splitting at %v", actualValue, expectedValue, key)
+		}
+		if actualValue, expectedValue := fmt.Sprint(left.Root.Size(), right.Root.Size()), fmt.Sprint(expectedLeft, size-expectedLeft); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v splitting at %v", actualValue, expectedValue, key)
+		}
+		right.Union(left)
+		tree = right
+		if actualValue, expectedValue := tree.Size(), size; actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+}
+
+func TestRedBlackTreeSetOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sizes := [][2]int{{0, 0}, {0, 10}, {10, 0}, {1, 1}, {10, 10}, {100, 5}, {5, 100}, {1000, 1000}, {2000, 30}}
+	for _, sizes := range sizes {
+		for _, arena := range []bool{false, true} {
+			keys1, keys2 := randomKeys(r, sizes[0]), randomKeys(r, sizes[1])
+			tests := []struct {
+				name      string
+				operation func(tree, other *Tree[int, int])
+				contains  func(in1, in2 bool) bool
+			}{
+				{"union", (*Tree[int, int]).Union, func(in1, in2 bool) bool { return in1 || in2 }},
+				{"intersection", (*Tree[int, int]).Intersection, func(in1, in2 bool) bool { return in1 && in2 }},
+				{"difference", (*Tree[int, int]).Difference, func(in1, in2 bool) bool { return in1 && !in2 }},
+			}
+			for _, test := range tests {
+				tree, other := newTestTree(keys1, 1, arena), newTestTree(keys2, 2, arena)
+				test.operation(tree, other)
+				if err := tree.Validate(); err != nil {
+					t.Fatalf("Got error %v for %v of %d and %d keys", err, test.name, sizes[0], sizes[1])
+				}
+				var expected []string
+				for key := 0; key < 4*max(sizes[0], sizes[1]); key++ {
+					in1, in2 := keys1[key], keys2[key]
+					if !test.contains(in1, in2) {
+						continue
+					}
+					if in1 {
+						expected = append(expected, fmt.Sprint(key, ":", 1)) // values of the tree win
+					} else {
+						expected = append(expected, fmt.Sprint(key, ":", 2))
+					}
+				}
+				var actual []string
+				for it := tree.Iterator(); it.Next(); {
+					actual = append(actual, fmt.Sprint(it.Key(), ":", it.Value()))
+				}
+				if actualValue, expectedValue := fmt.Sprint(actual), fmt.Sprint(expected); actualValue != expectedValue {
+					t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+				}
+				if actualValue, expectedValue := tree.Size(), len(expected); actualValue != expectedValue {
+					t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+				}
+				if actualValue, expectedValue := other.Empty(), true; actualValue != expectedValue {
+					t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.name)
+				}
+			}
+		}
+	}
+
+	// splitting and joining back restores the tree
+	tree := newTestTree(randomKeys(r, 500), 1, false)
+	expected := fmt.Sprint(tree.Keys())
+	left, right := tree.Split(700)
+	left.Union(right)
+	if err := left.Validate(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(left.Keys()), expected; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// operations with the tree itself
+	tree = newTestTree(randomKeys(r, 10), 1, false)
+	tree.Union(tree)
+	tree.Intersection(tree)
+	if actualValue, expectedValue := tree.Size(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	tree.Difference(tree)
+	if actualValue, expectedValue := tree.Size(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// randomKeys returns the given number of distinct keys between 0 and four times the number
+func randomKeys(r *rand.Rand, size int) map[int]bool {
+	keys := make(map[int]bool, size)
+	for len(keys) < size {
+		keys[r.Intn(4*size)] = true
+	}
+	return keys
+}
+
+// newTestTree returns a tree with the given keys, all mapped to the given value
+func newTestTree(keys map[int]bool, value int, arena bool) *Tree[int, int] {
+	tree := NewWithNumberComparator[int]()
+	if arena {
+		tree = NewWithArena[int, int](utils.NumberComparator[int], 16)
+	}
+	for key := range keys {
+		tree.Put(key, value)
+	}
+	return tree
+}
+
+func TestRedBlackTreeFromSorted(t *testing.T) {
+	for size := 0; size <= 300; size++ {
+		keys, values := make([]int, size), make([]int, size)
+		for i := range keys {
+			keys[i], values[i] = 2*i, i
+		}
+		tree := FromSorted(utils.NumberComparator[int], keys, values)
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Got error %v for %d keys", err, size)
+		}
+		if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), fmt.Sprint(keys, values); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := tree.Size(), size; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		tree.Put(-1, -1) // the tree stays balanced when modified afterwards
+		tree.Remove(size)
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Got error %v for %d keys after modification", err, size)
+		}
+	}
+
+	assertPanic := func(f func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Got %v expected a panic", r)
+			}
+		}()
+		f()
+	}
+	assertPanic(func() { FromSorted(utils.NumberComparator[int], []int{1, 2}, []string{"a"}) })
+	assertPanic(func() { FromSorted(utils.NumberComparator[int], []int{2, 1}, []string{"b", "a"}) })
+	assertPanic(func() { FromSorted(utils.NumberComparator[int], []int{1, 1}, []string{"a", "a"}) })
+}
+
+func TestRedBlackTreeFromSortedIterator(t *testing.T) {
+	source := NewWithNumberComparator[string]()
+	source.Put(3, "c")
+	source.Put(1, "a")
+	source.Put(2, "b")
+	it := source.Iterator()
+	tree := FromSortedIterator[int, string](utils.NumberComparator[int], &it)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[1 2 3] [a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// descending keys are rejected
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Got %v expected a panic", r)
+		}
+	}()
+	descending := NewWith[int, string](utils.Reverse(utils.NumberComparator[int]))
+	descending.Put(1, "a")
+	descending.Put(2, "b")
+	it = descending.Iterator()
+	FromSortedIterator[int, string](utils.NumberComparator[int], &it)
+}
+
+func TestRedBlackTreeFromJSONBuildsBalancedTree(t *testing.T) {
+	for _, tree := range []*Tree[int, int]{NewWithNumberComparator[int](), NewWithArena[int, int](utils.NumberComparator[int], 4)} {
+		tree.Put(1000, 0) // replaced by the JSON input
+		if err := tree.FromJSON([]byte(`{"5":5,"1":1,"3":3,"2":2,"4":4,"10":10}`)); err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		if actualValue, expectedValue := fmt.Sprint(tree.Keys(), tree.Values()), "[1 2 3 4 5 10] [1 2 3 4 5 10]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	// keys that the comparator considers equal are put one by one
+	tree := NewWith[int, int](func(a, b int) int { return utils.NumberComparator(a/10, b/10) })
+	if err := tree.FromJSON([]byte(`{"1":1,"2":2,"10":10}`)); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if actualValue, expectedValue := tree.Size(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// redNode returns a red node of the subtree below the given node, or nil if there is none
+func redNode[K comparable, T any](node *Node[K, T]) *Node[K, T] {
+	if node == nil || node.color == red {
+		return node
+	}
+	if left := redNode(node.Left); left != nil {
+		return left
+	}
+	return redNode(node.Right)
+}
+
+// constructors of the trees under benchmark, allocating the nodes one by one and from an arena
+var benchmarkTrees = []struct {
+	name string
+	new  func() *Tree[int, struct{}]
+}{
+	{"New", func() *Tree[int, struct{}] { return NewWithNumberComparator[struct{}]() }},
+	{"Arena", func() *Tree[int, struct{}] { return NewWithArena[int, struct{}](utils.NumberComparator[int], 1024) }},
+}
+
 func benchmarkGet(b *testing.B, tree *Tree[int, struct{}], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -731,130 +1352,190 @@ func benchmarkRemove(b *testing.B, tree *Tree[int, struct{}], size int) {
 }
 
 func BenchmarkRedBlackTreeGet100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeGet1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeGet10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeGet100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkGet(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkGet(b, tree, size)
 }
 
 func BenchmarkRedBlackTreePut100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}]()
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
+	}
 }
 
 func BenchmarkRedBlackTreePut1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkRedBlackTreePut10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkRedBlackTreePut100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkPut(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkPut(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeRemove100(b *testing.B) {
-	b.StopTimer()
-	size := 100
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeRemove1000(b *testing.B) {
-	b.StopTimer()
-	size := 1000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 1000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeRemove10000(b *testing.B) {
-	b.StopTimer()
-	size := 10000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 10000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
 	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
 }
 
 func BenchmarkRedBlackTreeRemove100000(b *testing.B) {
-	b.StopTimer()
-	size := 100000
-	tree := NewWithNumberComparator[struct{}]()
-	for n := 0; n < size; n++ {
-		tree.Put(n, struct{}{})
-	}
-	b.StartTimer()
-	benchmarkRemove(b, tree, size)
+	for _, newTree := range benchmarkTrees {
+		b.Run(newTree.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.StopTimer()
+			size := 100000
+			tree := newTree.new()
+			for n := 0; n < size; n++ {
+				tree.Put(n, struct{}{})
+			}
+			b.StartTimer()
+			benchmarkRemove(b, tree, size)
+		})
+	}
 }
@@ -0,0 +1,82 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package redblacktree
+
+import (
+	"fmt"
+
+	"github.com/ugurcsen/gods-generic/trees"
+)
+
+// Validate checks the invariants of the red-black tree and returns an error wrapping trees.ErrInvalid
+// describing the first violation found, or nil if the tree is valid:
+// keys are in ascending order, parent links are consistent, the root is black, red nodes have no red children,
+// every path from a node to its leaves has the same number of black nodes, and the sizes of the tree and of every
+// subtree match their node counts.
+// Meant for debugging and testing, it visits every node.
+func (tree *Tree[K, T]) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("%w: empty tree has size %d", trees.ErrInvalid, tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("%w: root %v has parent %v", trees.ErrInvalid, tree.Root, tree.Root.Parent)
+	}
+	if tree.Root.color != black {
+		return fmt.Errorf("%w: root %v is red", trees.ErrInvalid, tree.Root)
+	}
+	if _, err := tree.validate(tree.Root, nil, nil); err != nil {
+		return err
+	}
+	if tree.Root.Size() != tree.size {
+		return fmt.Errorf("%w: tree has %d nodes but size %d", trees.ErrInvalid, tree.Root.Size(), tree.size)
+	}
+	return nil
+}
+
+// validate checks the subtree whose keys have to be between the keys of the lower and upper nodes, if any,
+// and returns its black height.
+func (tree *Tree[K, T]) validate(node, lower, upper *Node[K, T]) (int, error) {
+	if node == nil {
+		return 1, nil
+	}
+	if lower != nil && tree.Comparator(lower.Key, node.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not after %v", trees.ErrInvalid, node, lower)
+	}
+	if upper != nil && tree.Comparator(node.Key, upper.Key) >= 0 {
+		return 0, fmt.Errorf("%w: node %v is not before %v", trees.ErrInvalid, node, upper)
+	}
+	for _, child := range []*Node[K, T]{node.Left, node.Right} {
+		if child == nil {
+			continue
+		}
+		if child.Parent != node {
+			return 0, fmt.Errorf("%w: child %v of node %v has parent %v", trees.ErrInvalid, child, node, child.Parent)
+		}
+		if node.color == red && child.color == red {
+			return 0, fmt.Errorf("%w: red node %v has red child %v", trees.ErrInvalid, node, child)
+		}
+	}
+	leftHeight, err := tree.validate(node.Left, lower, node)
+	if err != nil {
+		return 0, err
+	}
+	rightHeight, err := tree.validate(node.Right, node, upper)
+	if err != nil {
+		return 0, err
+	}
+	if leftHeight != rightHeight {
+		return 0, fmt.Errorf("%w: node %v has black heights %d and %d", trees.ErrInvalid, node, leftHeight, rightHeight)
+	}
+	if size := 1 + node.Left.Size() + node.Right.Size(); node.size != size {
+		return 0, fmt.Errorf("%w: node %v has %d nodes but size %d", trees.ErrInvalid, node, size, node.size)
+	}
+	if node.color == black {
+		leftHeight++
+	}
+	return leftHeight, nil
+}
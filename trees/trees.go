@@ -2,17 +2,25 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package trees provides an abstract Tree interface.
+// Package trees provides abstract Tree and Heap interfaces.
 //
 // In computer science, a tree is a widely used abstract data type (ADT) or data structure implementing this ADT that simulates a hierarchical tree structure, with a root value and subtrees of children with a parent node, represented as a set of linked nodes.
 //
 // Reference: https://en.wikipedia.org/wiki/Tree_%28data_structure%29
 package trees
 
-import "github.com/ugurcsen/gods-generic/containers"
+import (
+	"errors"
+
+	"github.com/ugurcsen/gods-generic/containers"
+)
+
+// ErrInvalid is wrapped by the errors returned from the Validate methods of the trees and heaps
+// when their structure violates an invariant, i.e. the structure was corrupted.
+var ErrInvalid = errors.New("trees: invariant violated")
 
 // Tree interface that all trees implement
-type Tree[T comparable] interface {
+type Tree[T any] interface {
 	containers.Container[T]
 	// Empty() bool
 	// Size() int
@@ -20,3 +28,20 @@ type Tree[T comparable] interface {
 	// Values() []interface{}
 	// String() string
 }
+
+// Heap interface that all heaps implement
+type Heap[T any] interface {
+	Push(values ...T)
+	Pop() (value T, ok bool)
+	Peek() (value T, ok bool)
+
+	Tree[T]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+
+	containers.JSONSerializer
+	containers.JSONDeserializer
+}
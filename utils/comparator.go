@@ -4,7 +4,12 @@
 
 package utils
 
-import "time"
+import (
+	"cmp"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
 
 // Comparator will make type assertion (see IntComparator for example),
 // which will panic if a or b are not of the asserted type.
@@ -19,7 +24,7 @@ type ComparableNumber interface {
 	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64
 }
 
-type Comparator[T comparable] func(a, b T) int
+type Comparator[T any] func(a, b T) int
 
 // StringComparator provides a fast comparison on strings
 func StringComparator(a, b string) int {
@@ -101,3 +106,152 @@ func TimeComparator(a, b time.Time) int {
 		return 0
 	}
 }
+
+// OrderedComparator provides a basic comparison on any ordered type (integers, floats and strings).
+// Floating-point NaNs are ordered before all other values.
+func OrderedComparator[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+// CaseInsensitiveStringComparator compares strings rune by rune after mapping both runes to lower case,
+// i.e. "ABC" and "abc" are considered equal.
+func CaseInsensitiveStringComparator(a, b string) int {
+	for len(a) > 0 && len(b) > 0 {
+		r1, size1 := utf8.DecodeRuneInString(a)
+		r2, size2 := utf8.DecodeRuneInString(b)
+		r1, r2 = unicode.ToLower(r1), unicode.ToLower(r2)
+		switch {
+		case r1 > r2:
+			return 1
+		case r1 < r2:
+			return -1
+		}
+		a, b = a[size1:], b[size2:]
+	}
+	switch {
+	case len(a) > 0:
+		return 1
+	case len(b) > 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// NaturalStringComparator compares strings in natural order, i.e. runs of decimal digits are compared by their
+// numeric value ("file2" < "file10"), while the rest of the string is compared byte by byte.
+// Strings that are equal in natural order (e.g. "a01" and "a1") are ordered with StringComparator.
+func NaturalStringComparator(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			start1, start2 := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+			number1, number2 := trimZeros(a[start1:i]), trimZeros(b[start2:j])
+			if len(number1) != len(number2) {
+				return NumberComparator(len(number1), len(number2))
+			}
+			if compare := StringComparator(number1, number2); compare != 0 {
+				return compare
+			}
+			continue
+		}
+		if compare := ByteComparator(a[i], b[j]); compare != 0 {
+			return compare
+		}
+		i++
+		j++
+	}
+	if compare := NumberComparator(len(a)-i, len(b)-j); compare != 0 {
+		return compare
+	}
+	return StringComparator(a, b)
+}
+
+// SliceComparator returns a comparator that compares slices lexicographically,
+// using the given comparator for the elements.
+// A slice that is a prefix of another slice is ordered first.
+func SliceComparator[T any](comparator Comparator[T]) Comparator[[]T] {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if compare := comparator(a[i], b[i]); compare != 0 {
+				return compare
+			}
+		}
+		return NumberComparator(len(a), len(b))
+	}
+}
+
+// Reverse returns a comparator that imposes the reverse ordering of the given comparator.
+func Reverse[T any](comparator Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return comparator(b, a)
+	}
+}
+
+// ThenComparing returns a comparator that orders by the first comparator and breaks ties with the next ones, in order.
+func ThenComparing[T any](first Comparator[T], next ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if compare := first(a, b); compare != 0 {
+			return compare
+		}
+		for _, comparator := range next {
+			if compare := comparator(a, b); compare != 0 {
+				return compare
+			}
+		}
+		return 0
+	}
+}
+
+// ComparingBy returns a comparator that orders values by the key extracted with the given function,
+// using the given comparator for the keys.
+func ComparingBy[T any, K any](key func(value T) K, comparator Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return comparator(key(a), key(b))
+	}
+}
+
+// ZeroFirst returns a comparator that orders zero values before all other values
+// and compares the other values with the given comparator.
+func ZeroFirst[T comparable](comparator Comparator[T]) Comparator[T] {
+	return zeroOrdered(comparator, -1)
+}
+
+// ZeroLast returns a comparator that orders zero values after all other values
+// and compares the other values with the given comparator.
+func ZeroLast[T comparable](comparator Comparator[T]) Comparator[T] {
+	return zeroOrdered(comparator, 1)
+}
+
+func zeroOrdered[T comparable](comparator Comparator[T], zeroOrder int) Comparator[T] {
+	var zero T
+	return func(a, b T) int {
+		switch {
+		case a == zero && b == zero:
+			return 0
+		case a == zero:
+			return zeroOrder
+		case b == zero:
+			return -zeroOrder
+		default:
+			return comparator(a, b)
+		}
+	}
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func trimZeros(number string) string {
+	for len(number) > 1 && number[0] == '0' {
+		number = number[1:]
+	}
+	return number
+}
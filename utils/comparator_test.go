@@ -305,3 +305,141 @@ func TestRuneComparator(t *testing.T) {
 		}
 	}
 }
+
+func TestOrderedComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{1.5, 1.5, 0},
+		{1.5, 2.5, -1},
+		{2.5, 1.5, 1},
+	}
+	for _, test := range tests {
+		actual := OrderedComparator(test[0].(float64), test[1].(float64))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+	if actual := OrderedComparator("a", "b"); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+}
+
+func TestCaseInsensitiveStringComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{"abc", "ABC", 0},
+		{"abc", "ABD", -1},
+		{"ABD", "abc", 1},
+		{"ab", "ABC", -1},
+		{"ÄBC", "äbc", 0},
+		{"", "", 0},
+		{"a", "", 1},
+	}
+	for _, test := range tests {
+		actual := CaseInsensitiveStringComparator(test[0].(string), test[1].(string))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestNaturalStringComparator(t *testing.T) {
+	tests := [][]interface{}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file10", "file10", 0},
+		{"file10a", "file10b", -1},
+		{"file", "file1", -1},
+		{"a2b3", "a2b10", -1},
+		{"a01", "a1", -1},
+		{"a1", "a01", 1},
+		{"a001", "a2", -1},
+		{"x", "1", 1},
+		{"", "", 0},
+	}
+	for _, test := range tests {
+		actual := NaturalStringComparator(test[0].(string), test[1].(string))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestSliceComparator(t *testing.T) {
+	comparator := SliceComparator(NumberComparator[int])
+	tests := [][]interface{}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2, 3}, []int{1, 2, 4}, -1},
+		{[]int{1, 3}, []int{1, 2, 4}, 1},
+		{[]int{1, 2}, []int{1, 2, 3}, -1},
+		{[]int{}, []int{}, 0},
+		{[]int{1}, []int(nil), 1},
+	}
+	for _, test := range tests {
+		actual := comparator(test[0].([]int), test[1].([]int))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	comparator := Reverse(NumberComparator[int])
+	if actual := comparator(1, 2); actual != 1 {
+		t.Errorf("Got %v expected %v", actual, 1)
+	}
+	if actual := comparator(2, 1); actual != -1 {
+		t.Errorf("Got %v expected %v", actual, -1)
+	}
+	if actual := comparator(1, 1); actual != 0 {
+		t.Errorf("Got %v expected %v", actual, 0)
+	}
+}
+
+func TestThenComparingAndComparingBy(t *testing.T) {
+	type Custom struct {
+		id   int
+		name string
+	}
+
+	comparator := ThenComparing(
+		ComparingBy(func(c Custom) string { return c.name }, StringComparator),
+		Reverse(ComparingBy(func(c Custom) int { return c.id }, NumberComparator[int])),
+	)
+
+	tests := [][]interface{}{
+		{Custom{1, "a"}, Custom{1, "a"}, 0},
+		{Custom{1, "a"}, Custom{1, "b"}, -1},
+		{Custom{2, "b"}, Custom{1, "a"}, 1},
+		{Custom{1, "a"}, Custom{2, "a"}, 1},
+		{Custom{2, "a"}, Custom{1, "a"}, -1},
+	}
+	for _, test := range tests {
+		actual := comparator(test[0].(Custom), test[1].(Custom))
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestZeroFirstAndZeroLast(t *testing.T) {
+	first := ZeroFirst(StringComparator)
+	last := ZeroLast(StringComparator)
+	tests := [][]interface{}{
+		{"", "", 0, 0},
+		{"", "a", -1, 1},
+		{"a", "", 1, -1},
+		{"a", "b", -1, -1},
+	}
+	for _, test := range tests {
+		if actual, expected := first(test[0].(string), test[1].(string)), test[2]; actual != expected {
+			t.Errorf("Got %v expected %v for %q and %q", actual, expected, test[0], test[1])
+		}
+		if actual, expected := last(test[0].(string), test[1].(string)), test[3]; actual != expected {
+			t.Errorf("Got %v expected %v for %q and %q", actual, expected, test[0], test[1])
+		}
+	}
+}
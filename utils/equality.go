@@ -0,0 +1,103 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "reflect"
+
+// Equality is used by containers whose elements do not need to be comparable,
+// e.g. to find a value in a list.
+//
+// Should return true if a is equal to b.
+type Equality[T any] func(a, b T) bool
+
+// DefaultEquality returns the equality used by containers when none is given.
+// Values of comparable types are compared with ==, all other values (slices, maps, functions,
+// or interfaces holding them) are compared with reflect.DeepEqual. Values of comparable types holding interfaces,
+// e.g. structs with an interface field, are compared with reflect.DeepEqual if an interface holds an incomparable
+// value, on which == would panic.
+func DefaultEquality[T any]() Equality[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	switch {
+	case t.Comparable() && !holdsInterface(t):
+		return func(a, b T) bool {
+			return any(a) == any(b)
+		}
+	case t.Comparable():
+		return func(a, b T) bool {
+			return comparableEqual(a, b)
+		}
+	default:
+		return func(a, b T) bool {
+			return reflect.DeepEqual(a, b)
+		}
+	}
+}
+
+// holdsInterface returns true if values of the type are or contain interfaces, whose dynamic values may be
+// incomparable.
+func holdsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return holdsInterface(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if holdsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// comparableEqual compares the values with == and falls back to reflect.DeepEqual if == panics,
+// because an interface within the values holds an incomparable value.
+func comparableEqual(a, b any) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = reflect.DeepEqual(a, b)
+		}
+	}()
+	return a == b
+}
+
+// SameElements returns true if both slices hold the same elements regardless of their order, i.e. they are equal as
+// multisets. The slices are sorted in place with the comparator and each run of elements the comparator considers
+// equal is matched with the equality, so that elements tied by the comparator may come in any order.
+// Complexity is O(n log n) plus the square of the length of the longest run of tied elements.
+func SameElements[T any](a, b []T, comparator Comparator[T], equal Equality[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	Sort(a, comparator)
+	Sort(b, comparator)
+	for start := 0; start < len(a); {
+		end := start + 1
+		for end < len(a) && comparator(a[start], a[end]) == 0 {
+			end++
+		}
+		// both slices are sorted, so the run has to span the same positions in b
+		if comparator(a[start], b[start]) != 0 || comparator(a[start], b[end-1]) != 0 ||
+			(end < len(b) && comparator(a[start], b[end]) == 0) {
+			return false
+		}
+		matched := make([]bool, end-start)
+		for _, value := range a[start:end] {
+			found := false
+			for index, other := range b[start:end] {
+				if !matched[index] && equal(value, other) {
+					matched[index], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		start = end
+	}
+	return true
+}
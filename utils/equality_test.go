@@ -0,0 +1,115 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "testing"
+
+func TestDefaultEqualityComparable(t *testing.T) {
+	equal := DefaultEquality[string]()
+	if actualValue := equal("a", "a"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := equal("a", "b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestDefaultEqualityNonComparable(t *testing.T) {
+	equal := DefaultEquality[[]int]()
+	if actualValue := equal([]int{1, 2}, []int{1, 2}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := equal([]int{1, 2}, []int{1}); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestDefaultEqualityInterface(t *testing.T) {
+	equal := DefaultEquality[interface{}]()
+
+	// i1,i2,expected
+	tests := [][]interface{}{
+		{1, 1, true},
+		{1, "1", false},
+		{nil, nil, true},
+		{nil, 1, false},
+		{[]int{1}, []int{1}, true},
+		{[]int{1}, 1, false},
+		{1, []int{1}, false},
+		{map[string]int{"a": 1}, map[string]int{"a": 1}, true},
+	}
+
+	for _, test := range tests {
+		actual := equal(test[0], test[1])
+		expected := test[2]
+		if actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestSameElements(t *testing.T) {
+	type item struct {
+		key  int
+		name string
+	}
+	byKey := func(a, b item) int { return NumberComparator(a.key, b.key) }
+	equal := DefaultEquality[item]()
+
+	// a,b,expected
+	tests := [][]interface{}{
+		{[]item{}, []item{}, true},
+		{[]item{{1, "a"}, {1, "b"}, {2, "c"}}, []item{{2, "c"}, {1, "b"}, {1, "a"}}, true},
+		{[]item{{1, "a"}, {1, "b"}, {2, "c"}}, []item{{1, "a"}, {1, "a"}, {2, "c"}}, false},
+		{[]item{{1, "a"}, {1, "a"}, {2, "c"}}, []item{{1, "a"}, {2, "c"}, {1, "a"}}, true},
+		{[]item{{1, "a"}, {2, "b"}}, []item{{1, "a"}, {1, "b"}}, false},
+		{[]item{{1, "a"}, {1, "b"}}, []item{{1, "a"}, {2, "b"}}, false},
+		{[]item{{1, "a"}}, []item{{1, "a"}, {1, "a"}}, false},
+	}
+
+	for _, test := range tests {
+		a, b := test[0].([]item), test[1].([]item)
+		if actual, expected := SameElements(a, b, byKey, equal), test[2]; actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+}
+
+func TestDefaultEqualityNestedInterface(t *testing.T) {
+	type box struct {
+		value interface{}
+	}
+	type pair struct {
+		boxes [2]box
+		name  string
+	}
+	equal := DefaultEquality[box]()
+	// box,box,expected
+	tests := [][]interface{}{
+		{box{1}, box{1}, true},
+		{box{1}, box{2}, false},
+		{box{[]int{1}}, box{[]int{1}}, true},
+		{box{[]int{1}}, box{[]int{2}}, false},
+		{box{[]int{1}}, box{1}, false},
+		{box{box{[]int{1}}}, box{box{[]int{1}}}, true},
+	}
+	for _, test := range tests {
+		if actual, expected := equal(test[0].(box), test[1].(box)), test[2]; actual != expected {
+			t.Errorf("Got %v expected %v for %v and %v", actual, expected, test[0], test[1])
+		}
+	}
+
+	pairEqual := DefaultEquality[pair]()
+	if actualValue := pairEqual(pair{[2]box{{[]int{1}}, {2}}, "a"}, pair{[2]box{{[]int{1}}, {2}}, "a"}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := pairEqual(pair{[2]box{{[]int{1}}, {2}}, "a"}, pair{[2]box{{[]int{1}}, {2}}, "b"}); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	interfaceEqual := DefaultEquality[interface{}]()
+	if actualValue := interfaceEqual(box{[]int{1}}, box{[]int{1}}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
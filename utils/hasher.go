@@ -0,0 +1,82 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"bytes"
+	"hash/maphash"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hasher is used by hash containers whose keys do not need to be comparable,
+// e.g. byte slices or strings compared without regard to case.
+//
+// Keys that are Equal must have the same Hash.
+type Hasher[K any] interface {
+	Hash(key K) uint64
+	Equal(a, b K) bool
+}
+
+// hashSeed randomizes the hashes of the hashers below, it is fixed for the lifetime of the process
+var hashSeed = maphash.MakeSeed()
+
+// NewHasher returns a hasher built from the given hash and equality functions.
+func NewHasher[K any](hash func(key K) uint64, equality Equality[K]) Hasher[K] {
+	return funcHasher[K]{hash: hash, equality: equality}
+}
+
+type funcHasher[K any] struct {
+	hash     func(key K) uint64
+	equality Equality[K]
+}
+
+func (h funcHasher[K]) Hash(key K) uint64 {
+	return h.hash(key)
+}
+
+func (h funcHasher[K]) Equal(a, b K) bool {
+	return h.equality(a, b)
+}
+
+// BytesHasher hashes byte slices by their contents.
+type BytesHasher struct{}
+
+// Hash returns the hash of the bytes.
+func (BytesHasher) Hash(key []byte) uint64 {
+	return maphash.Bytes(hashSeed, key)
+}
+
+// Equal returns true if both slices hold the same bytes.
+func (BytesHasher) Equal(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
+// CaseInsensitiveStringHasher hashes strings rune by rune after mapping the runes to lower case,
+// i.e. "ABC" and "abc" are considered equal, consistently with the CaseInsensitiveStringComparator.
+type CaseInsensitiveStringHasher struct{}
+
+// Hash returns the hash of the lower case string.
+func (CaseInsensitiveStringHasher) Hash(key string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	var buf [utf8.UTFMax]byte
+	for _, r := range key {
+		if r < utf8.RuneSelf {
+			if 'A' <= r && r <= 'Z' {
+				r += 'a' - 'A'
+			}
+			h.WriteByte(byte(r))
+			continue
+		}
+		h.Write(buf[:utf8.EncodeRune(buf[:], unicode.ToLower(r))])
+	}
+	return h.Sum64()
+}
+
+// Equal returns true if both strings are equal without regard to case.
+func (CaseInsensitiveStringHasher) Equal(a, b string) bool {
+	return CaseInsensitiveStringComparator(a, b) == 0
+}
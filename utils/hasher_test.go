@@ -0,0 +1,70 @@
+// Copyright (c) 2015, Emir Pasic. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import "testing"
+
+func TestBytesHasher(t *testing.T) {
+	hasher := BytesHasher{}
+	if actualValue := hasher.Equal([]byte("abc"), []byte("abc")); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := hasher.Equal([]byte("abc"), []byte("abd")); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := hasher.Equal(nil, []byte{}); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if hasher.Hash([]byte("abc")) != hasher.Hash([]byte("abc")) {
+		t.Errorf("Got different hashes for equal keys")
+	}
+	if hasher.Hash(nil) != hasher.Hash([]byte{}) {
+		t.Errorf("Got different hashes for equal keys")
+	}
+	if hasher.Hash([]byte("abc")) == hasher.Hash([]byte("abd")) {
+		t.Errorf("Got equal hashes for different keys")
+	}
+}
+
+func TestCaseInsensitiveStringHasher(t *testing.T) {
+	hasher := CaseInsensitiveStringHasher{}
+
+	// a, b, expectedEqual
+	tests := [][]interface{}{
+		{"abc", "ABC", true},
+		{"Content-Type", "content-type", true},
+		{"ÄÖÜ", "äöü", true},
+		{"ΣΑΣ", "σασ", true},
+		{"", "", true},
+		{"abc", "abd", false},
+		{"abc", "abcd", false},
+	}
+	for _, test := range tests {
+		a, b := test[0].(string), test[1].(string)
+		if actualValue := hasher.Equal(a, b); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for %q and %q", actualValue, test[2], a, b)
+		}
+		if actualValue := hasher.Equal(a, b) == (CaseInsensitiveStringComparator(a, b) == 0); actualValue != true {
+			t.Errorf("Got %v expected %v", actualValue, true)
+		}
+		if actualValue := hasher.Hash(a) == hasher.Hash(b); actualValue != test[2] {
+			t.Errorf("Got %v expected %v for hashes of %q and %q", actualValue, test[2], a, b)
+		}
+	}
+}
+
+func TestNewHasher(t *testing.T) {
+	type point struct {
+		x, y []int
+	}
+	hasher := NewHasher(func(key point) uint64 { return uint64(len(key.x) + len(key.y)) }, DefaultEquality[point]())
+	a, b := point{x: []int{1}, y: []int{2}}, point{x: []int{1}, y: []int{2}}
+	if actualValue := hasher.Equal(a, b); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := hasher.Hash(a), uint64(2); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -9,11 +9,121 @@ import "sort"
 // Sort sorts values (in-place) with respect to the given comparator.
 //
 // Uses Go's sort (hybrid of quicksort for large and then insertion sort for smaller slices).
-func Sort[T comparable](values []T, comparator Comparator[T]) {
+func Sort[T any](values []T, comparator Comparator[T]) {
 	sort.Sort(sortable[T]{values, comparator})
 }
 
-type sortable[T comparable] struct {
+// SortStable sorts values (in-place) with respect to the given comparator,
+// keeping the original order of equal values.
+//
+// Uses Go's stable sort (insertion sort on blocks, then in-place merging of blocks).
+func SortStable[T any](values []T, comparator Comparator[T]) {
+	sort.Stable(sortable[T]{values, comparator})
+}
+
+// PartialSort rearranges values (in-place) so that the first k values are the k smallest values in sorted order.
+// The order of the remaining values is unspecified.
+// Sorts all values if k is bigger than the number of values and does nothing if k is not positive.
+//
+// Complexity is O(n + k log k) on average.
+func PartialSort[T any](values []T, k int, comparator Comparator[T]) {
+	if k <= 0 {
+		return
+	}
+	if k >= len(values) {
+		Sort(values, comparator)
+		return
+	}
+	NthElement(values, k, comparator)
+	Sort(values[:k], comparator)
+}
+
+// NthElement rearranges values (in-place) so that the value at index n is the value that would be there
+// if all values were sorted, all values before it are less or equal and all values after it are greater or equal.
+// Does nothing if n is out of range.
+//
+// Uses quickselect with a median of three pivot, complexity is O(n) on average.
+func NthElement[T any](values []T, n int, comparator Comparator[T]) {
+	if n < 0 || n >= len(values) {
+		return
+	}
+	low, high := 0, len(values)-1
+	for high-low > 12 {
+		pivot := values[medianOfThree(values, low, low+(high-low)/2, high, comparator)]
+		i, j := low, high
+		for i <= j {
+			for comparator(values[i], pivot) < 0 {
+				i++
+			}
+			for comparator(values[j], pivot) > 0 {
+				j--
+			}
+			if i <= j {
+				values[i], values[j] = values[j], values[i]
+				i++
+				j--
+			}
+		}
+		switch {
+		case n <= j:
+			high = j
+		case n >= i:
+			low = i
+		default:
+			return // values between j and i are equal to the pivot
+		}
+	}
+	insertionSort(values[low:high+1], comparator)
+}
+
+// TopK returns the k greatest values with respect to the given comparator, ordered from the greatest to the smallest.
+// Returns all values (ordered) if k is bigger than the number of values. The passed values are not modified.
+//
+// Keeps a binary min-heap of the k greatest values seen so far, complexity is O(n log k).
+// The heap is kept in a slice rather than in a binaryheap.Heap, because package binaryheap imports package utils.
+func TopK[T any](values []T, k int, comparator Comparator[T]) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	if k > len(values) {
+		k = len(values)
+	}
+	heap := make([]T, k)
+	copy(heap, values[:k])
+	for index := k/2 - 1; index >= 0; index-- {
+		siftDown(heap, index, comparator)
+	}
+	for _, value := range values[k:] {
+		if comparator(value, heap[0]) > 0 {
+			heap[0] = value
+			siftDown(heap, 0, comparator)
+		}
+	}
+	// pop the smallest values to the back
+	for size := k - 1; size > 0; size-- {
+		heap[0], heap[size] = heap[size], heap[0]
+		siftDown(heap[:size], 0, comparator)
+	}
+	return heap
+}
+
+// BinarySearch searches for the target in values sorted in ascending order with respect to the given comparator.
+// Returns the index of the first value equal to the target and true if found,
+// otherwise the index where the target would be inserted to keep the values sorted and false.
+func BinarySearch[T any](values []T, target T, comparator Comparator[T]) (int, bool) {
+	low, high := 0, len(values)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if comparator(values[middle], target) < 0 {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low, low < len(values) && comparator(values[low], target) == 0
+}
+
+type sortable[T any] struct {
 	values     []T
 	comparator Comparator[T]
 }
@@ -27,3 +137,43 @@ func (s sortable[T]) Swap(i, j int) {
 func (s sortable[T]) Less(i, j int) bool {
 	return s.comparator(s.values[i], s.values[j]) < 0
 }
+
+func insertionSort[T any](values []T, comparator Comparator[T]) {
+	for i := 1; i < len(values); i++ {
+		for j := i; j > 0 && comparator(values[j], values[j-1]) < 0; j-- {
+			values[j], values[j-1] = values[j-1], values[j]
+		}
+	}
+}
+
+func medianOfThree[T any](values []T, a, b, c int, comparator Comparator[T]) int {
+	if comparator(values[a], values[b]) > 0 {
+		a, b = b, a
+	}
+	if comparator(values[b], values[c]) > 0 {
+		b = c
+		if comparator(values[a], values[b]) > 0 {
+			b = a
+		}
+	}
+	return b
+}
+
+// Moves the value at index down the min-heap until both children are greater or equal
+func siftDown[T any](heap []T, index int, comparator Comparator[T]) {
+	for {
+		smallest := index
+		left, right := 2*index+1, 2*index+2
+		if left < len(heap) && comparator(heap[left], heap[smallest]) < 0 {
+			smallest = left
+		}
+		if right < len(heap) && comparator(heap[right], heap[smallest]) < 0 {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+		heap[index], heap[smallest] = heap[smallest], heap[index]
+		index = smallest
+	}
+}
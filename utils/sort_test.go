@@ -92,6 +92,120 @@ func TestSortRandom(t *testing.T) {
 	}
 }
 
+func TestSortStable(t *testing.T) {
+	type User struct {
+		id   int
+		name string
+	}
+
+	users := []User{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}}
+	SortStable(users, ComparingBy(func(u User) int { return u.id }, NumberComparator[int]))
+
+	expected := []User{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}, {2, "e"}}
+	for i := range users {
+		if users[i] != expected[i] {
+			t.Errorf("Got %v expected %v", users, expected)
+			break
+		}
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	for _, size := range []int{1, 2, 13, 100, 1000} {
+		ints := make([]int, size)
+		for i := range ints {
+			ints[i] = rand.Intn(size/2 + 1) // many duplicates
+		}
+		sorted := append([]int{}, ints...)
+		Sort(sorted, NumberComparator[int])
+		for _, n := range []int{0, size / 3, size / 2, size - 1} {
+			values := append([]int{}, ints...)
+			NthElement(values, n, NumberComparator[int])
+			if values[n] != sorted[n] {
+				t.Errorf("Got %v expected %v for size %v and n %v", values[n], sorted[n], size, n)
+			}
+			for i := range values {
+				if (i < n && values[i] > values[n]) || (i > n && values[i] < values[n]) {
+					t.Errorf("Not partitioned around %v for size %v", n, size)
+					break
+				}
+			}
+		}
+	}
+	values := []int{3, 2, 1}
+	NthElement(values, 3, NumberComparator[int])
+	NthElement(values, -1, NumberComparator[int])
+	if values[0] != 3 || values[1] != 2 || values[2] != 1 {
+		t.Errorf("Got %v expected %v", values, []int{3, 2, 1})
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	ints := make([]int, 1000)
+	for i := range ints {
+		ints[i] = rand.Intn(500)
+	}
+	sorted := append([]int{}, ints...)
+	Sort(sorted, NumberComparator[int])
+	for _, k := range []int{0, 1, 10, 999, 1000, 2000} {
+		values := append([]int{}, ints...)
+		PartialSort(values, k, NumberComparator[int])
+		for i := 0; i < k && i < len(values); i++ {
+			if values[i] != sorted[i] {
+				t.Errorf("Got %v expected %v at %v for k %v", values[i], sorted[i], i, k)
+				break
+			}
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	ints := []int{5, 1, 9, 3, 7, 9, 2}
+	// k,expected
+	tests := [][]interface{}{
+		{0, []int{}},
+		{1, []int{9}},
+		{3, []int{9, 9, 7}},
+		{7, []int{9, 9, 7, 5, 3, 2, 1}},
+		{10, []int{9, 9, 7, 5, 3, 2, 1}},
+	}
+	for _, test := range tests {
+		actual := TopK(ints, test[0].(int), NumberComparator[int])
+		expected := test[1].([]int)
+		if SliceComparator(NumberComparator[int])(actual, expected) != 0 {
+			t.Errorf("Got %v expected %v", actual, expected)
+		}
+	}
+	if actual := TopK(ints, 2, Reverse(NumberComparator[int])); actual[0] != 1 || actual[1] != 2 {
+		t.Errorf("Got %v expected %v", actual, []int{1, 2})
+	}
+	if ints[0] != 5 || ints[6] != 2 {
+		t.Errorf("Values modified %v", ints)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	ints := []int{1, 3, 3, 3, 5, 7}
+	// target,index,found
+	tests := [][]interface{}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 4, false},
+		{7, 5, true},
+		{8, 6, false},
+	}
+	for _, test := range tests {
+		index, found := BinarySearch(ints, test[0].(int), NumberComparator[int])
+		if index != test[1] || found != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v for %v", index, found, test[1], test[2], test[0])
+		}
+	}
+	if index, found := BinarySearch([]int{}, 1, NumberComparator[int]); index != 0 || found {
+		t.Errorf("Got %v,%v expected %v,%v", index, found, 0, false)
+	}
+}
+
 func BenchmarkGoSortRandom(b *testing.B) {
 	b.StopTimer()
 	ints := []int{}